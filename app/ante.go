@@ -2,6 +2,7 @@ package app
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -10,6 +11,11 @@ import (
 	oraclekeeper "github.com/irisnet/irismod/modules/oracle/keeper"
 	oracletypes "github.com/irisnet/irismod/modules/oracle/types"
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	globalfeekeeper "github.com/irisnet/irishub/modules/globalfee/keeper"
+	globalfeetypes "github.com/irisnet/irishub/modules/globalfee/types"
+	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
 )
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -21,13 +27,19 @@ func NewAnteHandler(
 	tk tokenkeeper.Keeper,
 	ok oraclekeeper.Keeper,
 	oak oracletypes.AuthKeeper,
+	gk guardiankeeper.Keeper,
+	gfk globalfeekeeper.Keeper,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewRejectExtensionOptionsDecorator(),
+		guardiankeeper.NewCircuitBreakerDecorator(gk), // reject the disabled msg types before any fee is charged
+		guardiankeeper.NewBlocklistDecorator(gk),      // reject the txs signed by the frozen accounts before any fee is charged
+		guardiankeeper.NewFeeExemptionDecorator(gk),   // discount the min gas prices of the fee exempt txs before checking the fee
 		ante.NewMempoolFeeDecorator(),
+		NewGlobalFeeDecorator(gfk, tk), // enforce the global min gas prices in DeliverTx as well as in CheckTx
 		ante.NewValidateBasicDecorator(),
 		ante.TxTimeoutHeightDecorator{},
 		ante.NewValidateMemoDecorator(ak),
@@ -42,3 +54,75 @@ func NewAnteHandler(
 		oraclekeeper.NewValidateOracleAuthDecorator(ok, oak),
 	)
 }
+
+// GlobalFeeDecorator checks if the fee of the tx covers the global min gas prices set by governance.
+// Unlike the MempoolFeeDecorator it is enforced in DeliverTx as well as in CheckTx, so the txs paying
+// less than the global min gas prices are rejected by the whole network. The fee tokens are accepted
+// in place of the native token at their conversion rates. It must be placed after the
+// FeeExemptionDecorator so that the fee exempt txs are discounted.
+type GlobalFeeDecorator struct {
+	gfk globalfeekeeper.Keeper
+	tk  tokenkeeper.Keeper
+}
+
+// NewGlobalFeeDecorator returns a GlobalFeeDecorator
+func NewGlobalFeeDecorator(gfk globalfeekeeper.Keeper, tk tokenkeeper.Keeper) GlobalFeeDecorator {
+	return GlobalFeeDecorator{
+		gfk: gfk,
+		tk:  tk,
+	}
+}
+
+// AnteHandle returns an AnteHandler that checks if the fee of the tx covers the global min gas price
+// of any denom, the fee tokens paid being converted to the native token
+func (gfd GlobalFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the gentxs are free and the simulated txs are yet to be priced
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params := gfd.gfk.GetParams(ctx)
+	if params.MinGasPrices.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	fee := feeTx.GetFee()
+	gas := sdk.NewDec(int64(feeTx.GetGas()))
+	rate := sdk.OneDec().Sub(guardiankeeper.FeeDiscount(ctx))
+	nativeDenom := tokentypes.GetNativeToken().MinUnit
+
+	requiredFees := make(sdk.Coins, len(params.MinGasPrices))
+	for i, gp := range params.MinGasPrices {
+		required := gp.Amount.Mul(gas).Mul(rate).Ceil().RoundInt()
+		requiredFees[i] = sdk.NewCoin(gp.Denom, required)
+
+		paid := fee.AmountOf(gp.Denom)
+		if gp.Denom == nativeDenom {
+			paid = paid.Add(gfd.convertFeeTokens(ctx, fee, params))
+		}
+		if paid.GTE(required) {
+			return next(ctx, tx, simulate)
+		}
+	}
+
+	return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, requiredFees)
+}
+
+// convertFeeTokens returns the amount of the native token the fee tokens paid are worth,
+// the denoms which aren't issued by the token module are ignored
+func (gfd GlobalFeeDecorator) convertFeeTokens(ctx sdk.Context, fee sdk.Coins, params globalfeetypes.Params) sdk.Int {
+	converted := sdk.ZeroDec()
+	for _, coin := range fee {
+		token, ok := params.GetFeeToken(coin.Denom)
+		if !ok || !gfd.tk.HasToken(ctx, coin.Denom) {
+			continue
+		}
+		converted = converted.Add(token.Rate.MulInt(coin.Amount))
+	}
+	return converted.TruncateInt()
+}
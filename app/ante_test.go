@@ -0,0 +1,118 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	globalfeetypes "github.com/irisnet/irishub/modules/globalfee/types"
+	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func TestGlobalFeeDecorator(t *testing.T) {
+	app := newUpgradeTestApp(dbm.NewMemDB(), t.TempDir())
+
+	stateBytes, err := json.Marshal(NewDefaultGenesisState())
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := beginBlock(app, 1)
+
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, recipient := testdata.KeyTestPubAddr()
+	nativeDenom := tokentypes.GetNativeToken().MinUnit
+
+	newTx := func(fee string) sdk.Tx {
+		fees, err := sdk.ParseCoins(fee)
+		require.NoError(t, err)
+
+		txBuilder := MakeEncodingConfig().TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 1)))))
+		txBuilder.SetFeeAmount(fees)
+		txBuilder.SetGasLimit(100000)
+		return txBuilder.GetTx()
+	}
+
+	decorator := sdk.ChainAnteDecorators(
+		guardiankeeper.NewFeeExemptionDecorator(app.guardianKeeper),
+		NewGlobalFeeDecorator(app.globalFeeKeeper, app.tokenKeeper),
+	)
+
+	// no global min gas price is enforced by default
+	_, err = decorator(ctx, newTx(""), false)
+	require.NoError(t, err)
+
+	require.NoError(t, app.tokenKeeper.AddToken(ctx, tokentypes.NewToken("eth", "Ethereum", "ueth", 6, 1000, 0, true, sender)))
+	minGasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(nativeDenom, sdk.NewDecWithPrec(2, 1)),
+		sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 1)),
+	)
+	feeTokens := []globalfeetypes.FeeToken{
+		globalfeetypes.NewFeeToken("ueth", sdk.NewDec(2)),
+		globalfeetypes.NewFeeToken("ubtc", sdk.NewDec(10)),
+	}
+	app.globalFeeKeeper.SetParams(ctx, globalfeetypes.NewParams(minGasPrices, feeTokens))
+
+	testCases := []struct {
+		name    string
+		fee     string
+		expPass bool
+	}{
+		{"no fee", "", false},
+		{"insufficient native fee", "19999" + nativeDenom, false},
+		{"native fee", "20000" + nativeDenom, true},
+		{"fee of another denom", "10000uatom", true},
+		{"insufficient fee of each denom", "19999" + nativeDenom + ",9999uatom", false},
+		{"fee token", "10000ueth", true},
+		{"insufficient fee token", "9999ueth", false},
+		{"native fee and fee token", "10000" + nativeDenom + ",5000ueth", true},
+		{"fee token not issued", "10000ubtc", false},
+		{"denom not accepted", "100000uusdt", false},
+	}
+
+	for _, tc := range testCases {
+		_, err := decorator(ctx, newTx(tc.fee), false)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.True(t, sdkerrors.ErrInsufficientFee.Is(err), tc.name)
+		}
+	}
+
+	// the global min gas prices are enforced in CheckTx as well as in DeliverTx
+	_, err = decorator(ctx.WithIsCheckTx(true), newTx("19999"+nativeDenom), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+
+	// but neither to the simulations nor to the gentxs
+	_, err = decorator(ctx, newTx(""), true)
+	require.NoError(t, err)
+	_, err = decorator(ctx.WithBlockHeight(0), newTx(""), false)
+	require.NoError(t, err)
+
+	// the fee exempt txs are discounted
+	app.guardianKeeper.AddSuper(ctx, guardiantypes.NewSuper("genesis", guardiantypes.Genesis, sender, sender))
+	app.guardianKeeper.SetParams(ctx, guardiantypes.NewParams(
+		1, time.Hour, []string{guardiantypes.MsgTypeURL(&banktypes.MsgSend{})}, sdk.NewDecWithPrec(5, 1), 1,
+		guardiantypes.RoleOracleOperator,
+	))
+
+	_, err = decorator(ctx, newTx("10000"+nativeDenom), false)
+	require.NoError(t, err)
+
+	// until their signers are rate limited
+	_, err = decorator(ctx, newTx("10000"+nativeDenom), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+}
@@ -110,10 +110,15 @@ import (
 
 	"github.com/irisnet/irishub/address"
 	"github.com/irisnet/irishub/lite"
+	"github.com/irisnet/irishub/modules/globalfee"
+	globalfeekeeper "github.com/irisnet/irishub/modules/globalfee/keeper"
+	globalfeetypes "github.com/irisnet/irishub/modules/globalfee/types"
 	"github.com/irisnet/irishub/modules/guardian"
+	guardianclient "github.com/irisnet/irishub/modules/guardian/client"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
 	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 	"github.com/irisnet/irishub/modules/mint"
+	mintclient "github.com/irisnet/irishub/modules/mint/client"
 	mintkeeper "github.com/irisnet/irishub/modules/mint/keeper"
 	minttypes "github.com/irisnet/irishub/modules/mint/types"
 )
@@ -137,6 +142,8 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			mintclient.ProposalHandler,
+			guardianclient.AddSuperProposalHandler, guardianclient.DeleteSuperProposalHandler, guardianclient.ChangeSuperTypeProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -155,6 +162,7 @@ var (
 		service.AppModuleBasic{},
 		oracle.AppModuleBasic{},
 		random.AppModuleBasic{},
+		globalfee.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -231,6 +239,8 @@ type IrisApp struct {
 	oracleKeeper   oraclekeeper.Keeper
 	randomKeeper   randomkeeper.Keeper
 
+	globalFeeKeeper globalfeekeeper.Keeper
+
 	// the module manager
 	mm *module.Manager
 
@@ -300,7 +310,7 @@ func NewIrisApp(
 		guardiantypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
 		coinswaptypes.StoreKey, servicetypes.StoreKey, oracletypes.StoreKey, randomtypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, guardiantypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &IrisApp{
@@ -337,10 +347,6 @@ func NewIrisApp(
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.accountKeeper, app.bankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
-	app.mintKeeper = mintkeeper.NewKeeper(
-		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName),
-		app.accountKeeper, app.bankKeeper, authtypes.FeeCollectorName,
-	)
 	app.distrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.accountKeeper, app.bankKeeper,
 		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
@@ -364,13 +370,36 @@ func NewIrisApp(
 		appCodec, keys[ibchost.StoreKey], app.stakingKeeper, scopedIBCKeeper,
 	)
 
+	app.tokenKeeper = tokenkeeper.NewKeeper(
+		appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName),
+		app.bankKeeper, authtypes.FeeCollectorName,
+	)
+	app.mintKeeper = mintkeeper.NewKeeper(
+		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName),
+		app.accountKeeper, app.bankKeeper, &stakingKeeper, app.distrKeeper, app.tokenKeeper, authtypes.FeeCollectorName,
+	)
+
+	app.guardianKeeper = guardiankeeper.NewKeeper(
+		appCodec, keys[guardiantypes.StoreKey], tkeys[guardiantypes.TStoreKey], app.GetSubspace(guardiantypes.ModuleName),
+	)
+
+	app.globalFeeKeeper = globalfeekeeper.NewKeeper(app.GetSubspace(globalfeetypes.ModuleName))
+
+	// the param changes are checked against the constraints across the params and the module states
+	paramsHandler := params.NewParamChangeProposalHandler(app.paramsKeeper)
+	paramsHandler = globalfee.NewParamChangeProposalHandler(app.globalFeeKeeper, paramsHandler)
+	paramsHandler = guardian.NewParamChangeProposalHandler(app.guardianKeeper, paramsHandler)
+	paramsHandler = mint.NewParamChangeProposalHandler(app.mintKeeper, paramsHandler)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(paramproposal.RouterKey, paramsHandler).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper)).
+		AddRoute(minttypes.RouterKey, mint.NewMintBaseUpdateProposalHandler(app.mintKeeper)).
+		AddRoute(guardiantypes.RouterKey, guardian.NewProposalHandler(app.guardianKeeper))
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.accountKeeper, app.bankKeeper,
 		&stakingKeeper, govRouter,
@@ -401,11 +430,6 @@ func NewIrisApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.evidenceKeeper = *evidenceKeeper
 
-	app.guardianKeeper = guardiankeeper.NewKeeper(appCodec, keys[guardiantypes.StoreKey])
-	app.tokenKeeper = tokenkeeper.NewKeeper(
-		appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName),
-		app.bankKeeper, authtypes.FeeCollectorName,
-	)
 	app.recordKeeper = recordkeeper.NewKeeper(appCodec, keys[recordtypes.StoreKey])
 	app.nftKeeper = nftkeeper.NewKeeper(appCodec, keys[nfttypes.StoreKey])
 
@@ -455,7 +479,7 @@ func NewIrisApp(
 		ibc.NewAppModule(app.ibcKeeper),
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
-		guardian.NewAppModule(appCodec, app.guardianKeeper),
+		guardian.NewAppModule(appCodec, app.guardianKeeper, app.accountKeeper, app.bankKeeper),
 		token.NewAppModule(appCodec, app.tokenKeeper, app.accountKeeper, app.bankKeeper),
 		record.NewAppModule(appCodec, app.recordKeeper, app.accountKeeper, app.bankKeeper),
 		nft.NewAppModule(appCodec, app.nftKeeper, app.accountKeeper, app.bankKeeper),
@@ -464,6 +488,7 @@ func NewIrisApp(
 		service.NewAppModule(appCodec, app.serviceKeeper, app.accountKeeper, app.bankKeeper),
 		oracle.NewAppModule(appCodec, app.oracleKeeper),
 		random.NewAppModule(appCodec, app.randomKeeper, app.accountKeeper, app.bankKeeper),
+		globalfee.NewAppModule(appCodec, app.globalFeeKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -477,7 +502,7 @@ func NewIrisApp(
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
-		servicetypes.ModuleName,
+		servicetypes.ModuleName, guardiantypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -485,12 +510,15 @@ func NewIrisApp(
 	// NOTE: Capability module must occur first so that it can initialize any capabilities
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
+	// NOTE: The guardian and globalfee modules must occur before genutil so that their
+	// params are set when the gentxs go through the ante handler.
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
-		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
-		guardiantypes.ModuleName, tokentypes.ModuleName, nfttypes.ModuleName, htlctypes.ModuleName, recordtypes.ModuleName,
-		coinswaptypes.ModuleName, servicetypes.ModuleName, oracletypes.ModuleName, randomtypes.ModuleName,
+		ibchost.ModuleName, guardiantypes.ModuleName, globalfeetypes.ModuleName, genutiltypes.ModuleName,
+		evidencetypes.ModuleName, ibctransfertypes.ModuleName, tokentypes.ModuleName, nfttypes.ModuleName,
+		htlctypes.ModuleName, recordtypes.ModuleName, coinswaptypes.ModuleName, servicetypes.ModuleName,
+		oracletypes.ModuleName, randomtypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -517,7 +545,7 @@ func NewIrisApp(
 		evidence.NewAppModule(app.evidenceKeeper),
 		ibc.NewAppModule(app.ibcKeeper),
 		transferModule,
-		guardian.NewAppModule(appCodec, app.guardianKeeper),
+		guardian.NewAppModule(appCodec, app.guardianKeeper, app.accountKeeper, app.bankKeeper),
 		token.NewAppModule(appCodec, app.tokenKeeper, app.accountKeeper, app.bankKeeper),
 		record.NewAppModule(appCodec, app.recordKeeper, app.accountKeeper, app.bankKeeper),
 		nft.NewAppModule(appCodec, app.nftKeeper, app.accountKeeper, app.bankKeeper),
@@ -526,6 +554,7 @@ func NewIrisApp(
 		service.NewAppModule(appCodec, app.serviceKeeper, app.accountKeeper, app.bankKeeper),
 		oracle.NewAppModule(appCodec, app.oracleKeeper),
 		random.NewAppModule(appCodec, app.randomKeeper, app.accountKeeper, app.bankKeeper),
+		globalfee.NewAppModule(appCodec, app.globalFeeKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -543,12 +572,16 @@ func NewIrisApp(
 		app.bankKeeper,
 		app.tokenKeeper,
 		app.oracleKeeper,
+		guardiankeeper.NewRoleAuthorizer(app.guardianKeeper, guardiantypes.RoleOracleOperator),
 		app.guardianKeeper,
+		app.globalFeeKeeper,
 		ante.DefaultSigVerificationGasConsumer,
 		encodingConfig.TxConfig.SignModeHandler(),
 	))
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.registerUpgrades(upgrades)
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -743,6 +776,8 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(htlctypes.ModuleName)
 	paramsKeeper.Subspace(coinswaptypes.ModuleName)
 	paramsKeeper.Subspace(servicetypes.ModuleName)
+	paramsKeeper.Subspace(guardiantypes.ModuleName)
+	paramsKeeper.Subspace(globalfeetypes.ModuleName)
 
 	return paramsKeeper
 }
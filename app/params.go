@@ -1,5 +1,9 @@
 package app
 
+import (
+	guardiansim "github.com/irisnet/irishub/modules/guardian/simulation"
+)
+
 // Default simulation operation weights for messages and gov proposals
 const (
 	DefaultWeightMsgSend                        int = 100
@@ -16,6 +20,8 @@ const (
 	DefaultWeightMsgDelegate                    int = 100
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
+	DefaultWeightMsgAddSuper                    int = guardiansim.DefaultWeightMsgAddSuper
+	DefaultWeightMsgDeleteSuper                 int = guardiansim.DefaultWeightMsgDeleteSuper
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
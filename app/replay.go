@@ -1,58 +1,501 @@
 package app
 
-//import (
-//	"fmt"
-//	"github.com/cosmos/cosmos-sdk/server"
-//
-//	"github.com/spf13/viper"
-//
-//	bc "github.com/tendermint/tendermint/store"
-//	tmcli "github.com/tendermint/tendermint/libs/cli"
-//	"github.com/tendermint/tendermint/libs/log"
-//	"github.com/tendermint/tendermint/node"
-//	sm "github.com/tendermint/tendermint/state"
-//	dbm "github.com/tendermint/tm-db"
-//
-//)
-//
-//func Replay(logger log.Logger) int64 {
-//	ctx := server.NewDefaultContext()
-//	ctx.Config.RootDir = viper.GetString(tmcli.HomeFlag)
-//	dbContext := node.DBContext{ID: "state", Config: ctx.Config}
-//	dbType := dbm.BackendType(dbContext.Config.DBBackend)
-//	stateDB := dbm.NewDB(dbContext.ID, dbType, dbContext.Config.DBDir())
-//	defer stateDB.Close()
-//
-//	blockDBContext := node.DBContext{ID: "blockstore", Config: ctx.Config}
-//	blockStoreDB := dbm.NewDB(blockDBContext.ID, dbType, dbContext.Config.DBDir())
-//	defer blockStoreDB.Close()
-//	blockStore := bc.NewBlockStore(blockStoreDB)
-//
-//	curState := sm.LoadState(stateDB)
-//	preState := sm.LoadPreState(stateDB)
-//	if curState.LastBlockHeight == preState.LastBlockHeight && preState.LastBlockHeight == 0 {
-//		panic(fmt.Errorf("there is no block now, can't replay"))
-//	}
-//	var loadHeight int64
-//	if blockStore.Height() == curState.LastBlockHeight {
-//		logger.Info(fmt.Sprintf("Blockstore height equals to current state height %d", curState.LastBlockHeight))
-//		logger.Info("Just reset state DB to last height")
-//		sm.SaveState(stateDB, preState)
-//		loadHeight = preState.LastBlockHeight
-//	} else if blockStore.Height() == curState.LastBlockHeight+1 {
-//		logger.Info(fmt.Sprintf("Blockstore height %d, current state height %d", blockStore.Height(), curState.LastBlockHeight))
-//		logger.Info(fmt.Sprintf("Retreat block %d in block store and reset state DB to last height", blockStore.Height()))
-//		blockStore.RetreatLastBlock()
-//		sm.SaveState(stateDB, preState)
-//		loadHeight = preState.LastBlockHeight
-//	} else if blockStore.Height() == curState.LastBlockHeight+2 && curState.LastBlockHeight == preState.LastBlockHeight {
-//		logger.Info(fmt.Sprintf("Blockstore height %d, current state height %d, pre-state height %d", blockStore.Height(), curState.LastBlockHeight, preState.LastBlockHeight))
-//		logger.Info("State store has already been retreat to last height, only retreat block store to last height")
-//		blockStore.RetreatLastBlock()
-//		loadHeight = preState.LastBlockHeight
-//	} else {
-//		panic(fmt.Errorf("unexpected situation: tendermint block store height %d, tendermint state store height %d", blockStore.Height(), curState.LastBlockHeight))
-//	}
-//
-//	return loadHeight
-//}
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/iavl"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmstore "github.com/tendermint/tendermint/proto/tendermint/store"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// keys used by store/rootmulti of the SDK and store/store.go of tendermint. Neither exposes a way
+// to list the stores of a commit, to lower the latest version or to delete blocks, so the rollback
+// works on these keys directly. Before anything is written the rollback checks that the keys hold
+// what the public APIs read and fails otherwise, and TestRollbackKeyLayout checks them against the
+// stores written through the public APIs.
+const (
+	latestVersionKey = "s/latest"
+	pruneHeightsKey  = "s/pruneheights"
+	commitInfoKeyFmt = "s/%d"
+	storeKeyFmt      = "s/k:%s/"
+
+	blockMetaKeyFmt   = "H:%v"
+	blockPartKeyFmt   = "P:%v:%v"
+	blockCommitKeyFmt = "C:%v"
+	seenCommitKeyFmt  = "SC:%v"
+	blockHashKeyFmt   = "BH:%x"
+
+	iavlCacheSize = 10000
+)
+
+// RollbackPlan describes the heights of a node home before a rollback and the height it is rolled back to
+type RollbackPlan struct {
+	AppHeight    int64
+	StateHeight  int64
+	BlockBase    int64
+	BlockHeight  int64
+	TargetHeight int64
+	AppHash      []byte
+}
+
+// String implements fmt.Stringer
+func (p RollbackPlan) String() string {
+	return fmt.Sprintf(`Rollback Plan:
+  Application Height:  %d
+  State Height:        %d
+  Block Store:         %d-%d
+  Target Height:       %d
+  Target App Hash:     %X`,
+		p.AppHeight, p.StateHeight, p.BlockBase, p.BlockHeight, p.TargetHeight, p.AppHash,
+	)
+}
+
+// Rollback rolls the application multistore, the tendermint state and the block store of the node
+// at cfg back by the given number of heights. Nothing is written when dryRun is true.
+func Rollback(cfg *tmcfg.Config, heights int64, dryRun bool) (RollbackPlan, error) {
+	if heights <= 0 {
+		return RollbackPlan{}, fmt.Errorf("heights must be positive, got %d", heights)
+	}
+
+	backend := dbm.BackendType(cfg.DBBackend)
+
+	appDB, err := dbm.NewDB("application", backend, cfg.DBDir())
+	if err != nil {
+		return RollbackPlan{}, err
+	}
+	defer appDB.Close()
+
+	stateDB, err := dbm.NewDB("state", backend, cfg.DBDir())
+	if err != nil {
+		return RollbackPlan{}, err
+	}
+	defer stateDB.Close()
+
+	blockStoreDB, err := dbm.NewDB("blockstore", backend, cfg.DBDir())
+	if err != nil {
+		return RollbackPlan{}, err
+	}
+	defer blockStoreDB.Close()
+
+	stateStore := sm.NewStore(stateDB)
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return RollbackPlan{}, err
+	}
+	if state.IsEmpty() {
+		return RollbackPlan{}, fmt.Errorf("no tendermint state found in %s", cfg.DBDir())
+	}
+
+	appStore := rootmulti.NewStore(appDB)
+	if err := appStore.LoadLatestVersion(); err != nil {
+		return RollbackPlan{}, err
+	}
+
+	plan := RollbackPlan{
+		AppHeight:    appStore.LastCommitID().Version,
+		StateHeight:  state.LastBlockHeight,
+		BlockBase:    blockStore.Base(),
+		BlockHeight:  blockStore.Height(),
+		TargetHeight: state.LastBlockHeight - heights,
+	}
+
+	// the block store runs at most one height ahead of the state, the application at most one height
+	// ahead of the state and never behind the target
+	if plan.BlockHeight != plan.StateHeight && plan.BlockHeight != plan.StateHeight+1 {
+		return plan, fmt.Errorf(
+			"unexpected situation: block store height %d, state height %d", plan.BlockHeight, plan.StateHeight,
+		)
+	}
+	if plan.AppHeight < plan.TargetHeight || plan.AppHeight > plan.StateHeight+1 {
+		return plan, fmt.Errorf(
+			"unexpected situation: application height %d, state height %d", plan.AppHeight, plan.StateHeight,
+		)
+	}
+	if plan.TargetHeight < state.InitialHeight || plan.TargetHeight < plan.BlockBase {
+		return plan, fmt.Errorf(
+			"can't roll back to height %d, the earliest available height is %d",
+			plan.TargetHeight, max(state.InitialHeight, plan.BlockBase),
+		)
+	}
+
+	latestInfo, err := getCommitInfo(appDB, plan.AppHeight)
+	if err != nil {
+		return plan, err
+	}
+	targetInfo, err := getCommitInfo(appDB, plan.TargetHeight)
+	if err != nil {
+		return plan, err
+	}
+	if err := checkMultiStoreLayout(appDB, appStore.LastCommitID(), latestInfo, targetInfo); err != nil {
+		return plan, err
+	}
+	if err := checkBlockStoreLayout(blockStoreDB, blockStore, plan.TargetHeight); err != nil {
+		return plan, err
+	}
+
+	rolledBackState, err := loadStateAt(stateStore, blockStore, state, plan.TargetHeight)
+	if err != nil {
+		return plan, err
+	}
+	plan.AppHash = rolledBackState.AppHash
+
+	if err := appStore.LoadVersion(plan.TargetHeight); err != nil {
+		return plan, err
+	}
+	if appHash := appStore.LastCommitID().Hash; string(appHash) != string(rolledBackState.AppHash) {
+		return plan, fmt.Errorf(
+			"application hash %X at height %d doesn't match the tendermint state app hash %X",
+			appHash, plan.TargetHeight, rolledBackState.AppHash,
+		)
+	}
+
+	if dryRun {
+		return plan, nil
+	}
+
+	if err := rollbackMultiStore(appDB, latestInfo, targetInfo); err != nil {
+		return plan, err
+	}
+	if err := verifyMultiStore(appDB, targetInfo); err != nil {
+		return plan, err
+	}
+
+	if err := stateStore.Bootstrap(rolledBackState); err != nil {
+		return plan, err
+	}
+
+	if err := rollbackBlockStore(blockStoreDB, blockStore, plan.TargetHeight); err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
+// loadStateAt rebuilds the tendermint state as it was right after the block at the given height was
+// committed. The heights at which the validators and the consensus params last changed aren't
+// exposed by the state store, so the state records them as changed at the heights it is bootstrapped
+// for, like a state restored by state sync.
+func loadStateAt(stateStore sm.Store, blockStore *store.BlockStore, state sm.State, height int64) (sm.State, error) {
+	blockMeta := blockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return sm.State{}, fmt.Errorf("block meta at height %d not found", height)
+	}
+
+	// the header of the next block carries the app hash and the results hash of the target height
+	nextBlockMeta := blockStore.LoadBlockMeta(height + 1)
+	if nextBlockMeta == nil {
+		return sm.State{}, fmt.Errorf("block meta at height %d not found", height+1)
+	}
+
+	lastValidators, err := stateStore.LoadValidators(height)
+	if err != nil {
+		return sm.State{}, err
+	}
+	validators, err := stateStore.LoadValidators(height + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+	nextValidators, err := stateStore.LoadValidators(height + 2)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	consensusParams, err := stateStore.LoadConsensusParams(height + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	version := state.Version
+	version.Consensus.App = consensusParams.Version.AppVersion
+
+	return sm.State{
+		Version:       version,
+		ChainID:       state.ChainID,
+		InitialHeight: state.InitialHeight,
+
+		LastBlockHeight: height,
+		LastBlockID:     blockMeta.BlockID,
+		LastBlockTime:   blockMeta.Header.Time,
+
+		NextValidators:              nextValidators,
+		Validators:                  validators,
+		LastValidators:              lastValidators,
+		LastHeightValidatorsChanged: height + 2,
+
+		ConsensusParams:                  consensusParams,
+		LastHeightConsensusParamsChanged: height + 1,
+
+		LastResultsHash: nextBlockMeta.Header.LastResultsHash,
+		AppHash:         nextBlockMeta.Header.AppHash,
+	}, nil
+}
+
+// checkMultiStoreLayout checks that the multistore keys the rollback works on hold the latest commit
+// loaded through rootmulti, and that the iavl trees of the stores are where the rollback overwrites them
+func checkMultiStoreLayout(db dbm.DB, latestID storetypes.CommitID, latestInfo, targetInfo *storetypes.CommitInfo) error {
+	bz, err := db.Get([]byte(latestVersionKey))
+	if err != nil {
+		return err
+	}
+	var latestVersion int64
+	if err := gogotypes.StdInt64Unmarshal(&latestVersion, bz); err != nil || latestVersion != latestID.Version {
+		return fmt.Errorf("unrecognised multistore layout: latest version %d not found", latestID.Version)
+	}
+	if latestInfo.Version != latestID.Version || !bytes.Equal(latestInfo.CommitID().Hash, latestID.Hash) {
+		return fmt.Errorf("unrecognised multistore layout: commit info at height %d doesn't match", latestID.Version)
+	}
+	if _, err := getPruningHeights(db); err != nil {
+		return err
+	}
+
+	for _, storeInfo := range latestInfo.StoreInfos {
+		if storeInfo.CommitId.Version == 0 {
+			continue
+		}
+
+		tree, err := loadTree(db, storeInfo.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := tree.LoadVersion(storeInfo.CommitId.Version); err != nil || !bytes.Equal(tree.Hash(), storeInfo.CommitId.Hash) {
+			return fmt.Errorf("unrecognised multistore layout: store %s at height %d not found", storeInfo.Name, latestInfo.Version)
+		}
+	}
+	for _, storeInfo := range targetInfo.StoreInfos {
+		if storeInfo.CommitId.Version == 0 {
+			continue
+		}
+
+		tree, err := loadTree(db, storeInfo.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := tree.LoadVersion(storeInfo.CommitId.Version); err != nil || !bytes.Equal(tree.Hash(), storeInfo.CommitId.Hash) {
+			return fmt.Errorf("store %s at height %d not found, it may have been pruned", storeInfo.Name, targetInfo.Version)
+		}
+	}
+
+	return nil
+}
+
+// rollbackMultiStore deletes all versions above the target from every store of the multistore
+func rollbackMultiStore(db dbm.DB, latestInfo, targetInfo *storetypes.CommitInfo) error {
+	targetStores := make(map[string]bool, len(targetInfo.StoreInfos))
+	for _, storeInfo := range targetInfo.StoreInfos {
+		targetStores[storeInfo.Name] = true
+	}
+
+	for _, storeInfo := range latestInfo.StoreInfos {
+		// memory stores are committed with an empty commit id and have nothing on disk
+		if storeInfo.CommitId.Version == 0 {
+			continue
+		}
+
+		// stores added by an upgrade after the target height are emptied
+		version := targetInfo.Version
+		if !targetStores[storeInfo.Name] {
+			version = 0
+		}
+
+		tree, err := loadTree(db, storeInfo.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := tree.LoadVersionForOverwriting(version); err != nil {
+			return fmt.Errorf("failed to roll back store %s: %w", storeInfo.Name, err)
+		}
+	}
+
+	pruneHeights, err := getPruningHeights(db)
+	if err != nil {
+		return err
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	for version := targetInfo.Version + 1; version <= latestInfo.Version; version++ {
+		if err := batch.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, version))); err != nil {
+			return err
+		}
+	}
+
+	bz, err := gogotypes.StdInt64Marshal(targetInfo.Version)
+	if err != nil {
+		return err
+	}
+	if err := batch.Set([]byte(latestVersionKey), bz); err != nil {
+		return err
+	}
+
+	// drop the pending pruning of versions which no longer exist
+	heights := make([]byte, 0, 8*len(pruneHeights))
+	for _, h := range pruneHeights {
+		if h <= targetInfo.Version {
+			buf := make([]byte, 8)
+			binary.BigEndian.PutUint64(buf, uint64(h))
+			heights = append(heights, buf...)
+		}
+	}
+	if err := batch.Set([]byte(pruneHeightsKey), heights); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// verifyMultiStore checks that rootmulti loads the stores of the target commit as the latest version
+func verifyMultiStore(db dbm.DB, targetInfo *storetypes.CommitInfo) error {
+	ms := rootmulti.NewStore(db)
+	keys := make(map[string]storetypes.StoreKey)
+	for _, storeInfo := range targetInfo.StoreInfos {
+		if storeInfo.CommitId.Version == 0 {
+			continue
+		}
+		keys[storeInfo.Name] = storetypes.NewKVStoreKey(storeInfo.Name)
+		ms.MountStoreWithDB(keys[storeInfo.Name], storetypes.StoreTypeIAVL, nil)
+	}
+	if err := ms.LoadLatestVersion(); err != nil {
+		return fmt.Errorf("failed to load the rolled back multistore: %w", err)
+	}
+
+	if ms.LastCommitID().Version != targetInfo.Version {
+		return fmt.Errorf("the rolled back multistore loads height %d instead of %d", ms.LastCommitID().Version, targetInfo.Version)
+	}
+	for _, storeInfo := range targetInfo.StoreInfos {
+		key, ok := keys[storeInfo.Name]
+		if !ok {
+			continue
+		}
+		if commitID := ms.GetCommitKVStore(key).LastCommitID(); !bytes.Equal(commitID.Hash, storeInfo.CommitId.Hash) {
+			return fmt.Errorf("the rolled back store %s has hash %X instead of %X", storeInfo.Name, commitID.Hash, storeInfo.CommitId.Hash)
+		}
+	}
+
+	return nil
+}
+
+// checkBlockStoreLayout checks that the block store keys the rollback deletes hold the blocks above the given height
+func checkBlockStoreLayout(db dbm.DB, blockStore *store.BlockStore, height int64) error {
+	for h := height + 1; h <= blockStore.Height(); h++ {
+		meta := blockStore.LoadBlockMeta(h)
+		if meta == nil {
+			continue
+		}
+
+		// the commit of the last block is stored with the next one
+		for _, key := range blockKeys(meta) {
+			if h == blockStore.Height() && bytes.Equal(key, []byte(fmt.Sprintf(blockCommitKeyFmt, h))) {
+				continue
+			}
+			if has, err := db.Has(key); err != nil || !has {
+				return fmt.Errorf("unrecognised block store layout: %s not found", key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// rollbackBlockStore deletes all blocks above the given height from the block store
+func rollbackBlockStore(db dbm.DB, blockStore *store.BlockStore, height int64) error {
+	base, latest := blockStore.Base(), blockStore.Height()
+
+	// lower the height first so that nobody tries to access the deleted blocks
+	store.SaveBlockStoreState(&tmstore.BlockStoreState{Base: base, Height: height}, db)
+
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	for h := height + 1; h <= latest; h++ {
+		meta := blockStore.LoadBlockMeta(h)
+		if meta == nil {
+			continue
+		}
+
+		for _, key := range blockKeys(meta) {
+			if err := batch.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// blockKeys returns the keys of the block store entries of the block with the given meta
+func blockKeys(meta *tmtypes.BlockMeta) [][]byte {
+	height := meta.Header.Height
+	keys := [][]byte{
+		[]byte(fmt.Sprintf(blockMetaKeyFmt, height)),
+		[]byte(fmt.Sprintf(blockHashKeyFmt, []byte(meta.BlockID.Hash))),
+		[]byte(fmt.Sprintf(blockCommitKeyFmt, height)),
+		[]byte(fmt.Sprintf(seenCommitKeyFmt, height)),
+	}
+	for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+		keys = append(keys, []byte(fmt.Sprintf(blockPartKeyFmt, height, p)))
+	}
+
+	return keys
+}
+
+func getCommitInfo(db dbm.DB, version int64) (*storetypes.CommitInfo, error) {
+	bz, err := db.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, version)))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("no application commit info found at height %d", version)
+	}
+
+	cInfo := &storetypes.CommitInfo{}
+	if err := cInfo.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return cInfo, nil
+}
+
+// loadTree returns the iavl tree of the store with the given name, the iavl version is the one the
+// SDK stores are built on
+func loadTree(db dbm.DB, name string) (*iavl.MutableTree, error) {
+	return iavl.NewMutableTree(dbm.NewPrefixDB(db, []byte(fmt.Sprintf(storeKeyFmt, name))), iavlCacheSize)
+}
+
+func getPruningHeights(db dbm.DB) ([]int64, error) {
+	bz, err := db.Get([]byte(pruneHeightsKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz)%8 != 0 {
+		return nil, fmt.Errorf("unrecognised multistore layout: invalid pruning heights %X", bz)
+	}
+
+	heights := make([]int64, 0, len(bz)/8)
+	for offset := 0; offset+8 <= len(bz); offset += 8 {
+		heights = append(heights, int64(binary.BigEndian.Uint64(bz[offset:offset+8])))
+	}
+
+	return heights, nil
+}
+
+func max(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,282 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/iavl"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/mempool/mock"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const testChainID = "rollback-chain"
+
+// testChain runs a single validator chain on a local LevelDB home by driving the
+// tendermint block executor directly
+type testChain struct {
+	app        *baseapp.BaseApp
+	proxyApp   proxy.AppConns
+	blockExec  *sm.BlockExecutor
+	blockStore *store.BlockStore
+	dbs        []dbm.DB
+
+	pv         tmtypes.PrivValidator
+	state      sm.State
+	lastCommit *tmtypes.Commit
+	interval   time.Duration
+}
+
+func openTestChain(t *testing.T, cfg *tmcfg.Config, pv tmtypes.MockPV, interval time.Duration) *testChain {
+	backend := dbm.BackendType(cfg.DBBackend)
+
+	appDB, err := dbm.NewDB("application", backend, cfg.DBDir())
+	require.NoError(t, err)
+	stateDB, err := dbm.NewDB("state", backend, cfg.DBDir())
+	require.NoError(t, err)
+	blockStoreDB, err := dbm.NewDB("blockstore", backend, cfg.DBDir())
+	require.NoError(t, err)
+
+	// the block time is written to a store on every block, the other store stays empty
+	key, emptyKey := sdk.NewKVStoreKey("test"), sdk.NewKVStoreKey("empty")
+	app := baseapp.NewBaseApp("test", log.NewNopLogger(), appDB, nil)
+	app.MountStores(key, emptyKey)
+	app.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		ctx.KVStore(key).Set([]byte("time"), sdk.FormatTimeBytes(ctx.BlockTime()))
+		return abci.ResponseBeginBlock{}
+	})
+	require.NoError(t, app.LoadLatestVersion())
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+	require.NoError(t, proxyApp.Start())
+
+	stateStore := sm.NewStore(stateDB)
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+
+	lastCommit := new(tmtypes.Commit)
+	if state.IsEmpty() {
+		pubKey, err := pv.GetPubKey()
+		require.NoError(t, err)
+
+		genDoc := &tmtypes.GenesisDoc{
+			ChainID:     testChainID,
+			GenesisTime: tmtime.Now(),
+			Validators:  []tmtypes.GenesisValidator{{PubKey: pubKey, Power: 10}},
+		}
+		require.NoError(t, genDoc.ValidateAndComplete())
+
+		state, err = sm.MakeGenesisState(genDoc)
+		require.NoError(t, err)
+
+		_, err = proxyApp.Consensus().InitChainSync(abci.RequestInitChain{ChainId: testChainID})
+		require.NoError(t, err)
+		require.NoError(t, stateStore.Save(state))
+	} else {
+		lastCommit = blockStore.LoadSeenCommit(state.LastBlockHeight)
+	}
+
+	return &testChain{
+		app:        app,
+		proxyApp:   proxyApp,
+		blockExec:  sm.NewBlockExecutor(stateStore, log.NewNopLogger(), proxyApp.Consensus(), mock.Mempool{}, sm.EmptyEvidencePool{}),
+		blockStore: blockStore,
+		dbs:        []dbm.DB{appDB, stateDB, blockStoreDB},
+		pv:         pv,
+		state:      state,
+		lastCommit: lastCommit,
+		interval:   interval,
+	}
+}
+
+func (c *testChain) produceBlocks(t *testing.T, n int) {
+	for i := 0; i < n; i++ {
+		height := c.state.LastBlockHeight + 1
+
+		block, partSet := c.state.MakeBlock(height, nil, c.lastCommit, nil, c.state.Validators.Proposer.Address)
+		blockID := tmtypes.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+
+		voteSet := tmtypes.NewVoteSet(testChainID, height, 0, tmproto.PrecommitType, c.state.Validators)
+		commit, err := tmtypes.MakeCommit(blockID, height, 0, voteSet, []tmtypes.PrivValidator{c.pv}, block.Time.Add(c.interval))
+		require.NoError(t, err)
+
+		c.blockStore.SaveBlock(block, partSet, commit)
+
+		c.state, _, err = c.blockExec.ApplyBlock(c.state, blockID, block)
+		require.NoError(t, err)
+
+		c.lastCommit = commit
+	}
+}
+
+func (c *testChain) close(t *testing.T) {
+	require.NoError(t, c.proxyApp.Stop())
+	for _, db := range c.dbs {
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestRollback(t *testing.T) {
+	cfg := tmcfg.DefaultConfig()
+	cfg.SetRoot(t.TempDir())
+
+	pv := tmtypes.NewMockPV()
+
+	chain := openTestChain(t, cfg, pv, time.Second)
+	chain.produceBlocks(t, 10)
+	appHash := []byte(chain.blockStore.LoadBlockMeta(8).Header.AppHash)
+	blockHash := []byte(chain.blockStore.LoadBlockMeta(8).BlockID.Hash)
+	chain.close(t)
+
+	_, err := Rollback(cfg, 0, true)
+	require.Error(t, err)
+
+	_, err = Rollback(cfg, 10, true)
+	require.Error(t, err)
+
+	// a dry run doesn't write anything
+	for i := 0; i < 2; i++ {
+		plan, err := Rollback(cfg, 3, true)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), plan.AppHeight)
+		require.Equal(t, int64(10), plan.StateHeight)
+		require.Equal(t, int64(1), plan.BlockBase)
+		require.Equal(t, int64(10), plan.BlockHeight)
+		require.Equal(t, int64(7), plan.TargetHeight)
+		require.Equal(t, appHash, plan.AppHash)
+	}
+
+	plan, err := Rollback(cfg, 3, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), plan.TargetHeight)
+
+	// the app, the state and the block store are back at height 7
+	chain = openTestChain(t, cfg, pv, 2*time.Second)
+	require.Equal(t, int64(7), chain.state.LastBlockHeight)
+	require.Equal(t, int64(7), chain.blockStore.Height())
+	require.Nil(t, chain.blockStore.LoadBlockMeta(8))
+	require.Nil(t, chain.blockStore.LoadBlockByHash(blockHash))
+	require.Equal(t, int64(7), chain.app.LastBlockHeight())
+	require.Equal(t, appHash, chain.app.LastCommitID().Hash)
+	require.Equal(t, appHash, []byte(chain.state.AppHash))
+
+	// the chain continues on top of the rolled back height with blocks which differ from the original ones
+	chain.produceBlocks(t, 5)
+	require.Equal(t, int64(12), chain.state.LastBlockHeight)
+	require.Equal(t, int64(12), chain.app.LastBlockHeight())
+	chain.close(t)
+
+	plan, err = Rollback(cfg, 1, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), plan.TargetHeight)
+}
+
+func TestRollbackUnrecognisedLayout(t *testing.T) {
+	cfg := tmcfg.DefaultConfig()
+	cfg.SetRoot(t.TempDir())
+
+	chain := openTestChain(t, cfg, tmtypes.NewMockPV(), time.Second)
+	chain.produceBlocks(t, 5)
+	appDB := chain.dbs[0]
+
+	// move the tree of a store to another prefix
+	prefix := []byte(fmt.Sprintf(storeKeyFmt, "test"))
+	iterator, err := appDB.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	require.NoError(t, err)
+	batch := appDB.NewBatch()
+	for ; iterator.Valid(); iterator.Next() {
+		require.NoError(t, batch.Set(append([]byte("moved/"), iterator.Key()...), iterator.Value()))
+		require.NoError(t, batch.Delete(iterator.Key()))
+	}
+	require.NoError(t, iterator.Close())
+	require.NoError(t, batch.WriteSync())
+	require.NoError(t, batch.Close())
+	chain.close(t)
+
+	// the rollback fails without writing anything
+	_, err = Rollback(cfg, 2, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unrecognised multistore layout")
+
+	plan, err := Rollback(cfg, 2, true)
+	require.Error(t, err)
+	require.Equal(t, int64(5), plan.AppHeight)
+	require.Equal(t, int64(5), plan.BlockHeight)
+}
+
+// TestRollbackKeyLayout fails when the multistore or the block store change the layout of the keys
+// the rollback works on
+func TestRollbackKeyLayout(t *testing.T) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey("test")
+
+	ms := rootmulti.NewStore(db)
+	ms.SetPruning(storetypes.PruneEverything)
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 0; i < 3; i++ {
+		ms.GetCommitKVStore(key).Set([]byte("height"), []byte{byte(i)})
+		ms.Commit()
+	}
+
+	bz, err := db.Get([]byte(latestVersionKey))
+	require.NoError(t, err)
+	var latestVersion int64
+	require.NoError(t, gogotypes.StdInt64Unmarshal(&latestVersion, bz))
+	require.Equal(t, int64(3), latestVersion)
+
+	cInfo, err := getCommitInfo(db, 3)
+	require.NoError(t, err)
+	require.Equal(t, ms.LastCommitID(), cInfo.CommitID())
+	require.Len(t, cInfo.StoreInfos, 1)
+	require.Equal(t, key.Name(), cInfo.StoreInfos[0].Name)
+
+	pruneHeights, err := getPruningHeights(db)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, pruneHeights)
+
+	tree, err := iavl.NewMutableTree(dbm.NewPrefixDB(db, []byte(fmt.Sprintf(storeKeyFmt, key.Name()))), iavlCacheSize)
+	require.NoError(t, err)
+	version, err := tree.LoadVersion(0)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), version)
+	require.Equal(t, ms.GetCommitKVStore(key).LastCommitID().Hash, tree.Hash())
+
+	cfg := tmcfg.DefaultConfig()
+	cfg.SetRoot(t.TempDir())
+
+	chain := openTestChain(t, cfg, tmtypes.NewMockPV(), time.Second)
+	defer chain.close(t)
+	chain.produceBlocks(t, 3)
+
+	// the commit of a block is stored with the next block
+	blockStoreDB := chain.dbs[2]
+	for height := int64(1); height <= 2; height++ {
+		for _, key := range blockKeys(chain.blockStore.LoadBlockMeta(height)) {
+			has, err := blockStoreDB.Has(key)
+			require.NoError(t, err)
+			require.True(t, has, "block store key %s not found", key)
+		}
+	}
+}
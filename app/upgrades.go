@@ -0,0 +1,167 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	globalfeetypes "github.com/irisnet/irishub/modules/globalfee/types"
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+// Upgrade defines a named software upgrade which is executed when the SoftwareUpgradeProposal
+// with the same name gets to its upgrade height
+type Upgrade struct {
+	// Name is the name of the upgrade plan
+	Name string
+	// StoreUpgrades are the stores added, renamed and deleted by the upgrade. The stores added or
+	// renamed to must be mounted by the new binary, and so must the stores deleted since the
+	// multistore keeps them around
+	StoreUpgrades storetypes.StoreUpgrades
+	// Migrations are the module state migrations run in order at the upgrade height
+	Migrations []Migration
+}
+
+// Migration defines the state migration of a module
+type Migration struct {
+	Module  string
+	Migrate func(ctx sdk.Context, app *IrisApp) error
+}
+
+// upgrades is the registry of the upgrades known to this binary
+var upgrades = []Upgrade{
+	{
+		// v1.1 sets the params added to mint and guardian and the params of globalfee, which
+		// the chain started without, and grants roles to the existing supers. globalfee only
+		// has a param subspace, so there is no store to add.
+		Name: "v1.1",
+		Migrations: []Migration{
+			{
+				Module: minttypes.ModuleName,
+				Migrate: func(ctx sdk.Context, app *IrisApp) error {
+					params := minttypes.DefaultParams()
+					setDefaultParams(ctx, app.GetSubspace(minttypes.ModuleName), &params)
+					return app.mintKeeper.GetParamSet(ctx).Validate()
+				},
+			},
+			{
+				Module: guardiantypes.ModuleName,
+				Migrate: func(ctx sdk.Context, app *IrisApp) error {
+					params := guardiantypes.DefaultParams()
+					setDefaultParams(ctx, app.GetSubspace(guardiantypes.ModuleName), &params)
+					return app.guardianKeeper.GetParams(ctx).Validate()
+				},
+			},
+			{
+				// the oracle is authorized by RoleOracleOperator, which the ordinary supers
+				// added before the roles existed don't have
+				Module: guardiantypes.ModuleName,
+				Migrate: func(ctx sdk.Context, app *IrisApp) error {
+					var supers []guardiantypes.Super
+					app.guardianKeeper.IterateSupers(ctx, func(super guardiantypes.Super) bool {
+						if super.AccountType == guardiantypes.Ordinary && len(super.Roles) == 0 {
+							supers = append(supers, super)
+						}
+						return false
+					})
+					for _, super := range supers {
+						super.Roles = []guardiantypes.Role{guardiantypes.RoleOracleOperator}
+						app.guardianKeeper.AddSuper(ctx, super)
+					}
+					return nil
+				},
+			},
+			{
+				Module: globalfeetypes.ModuleName,
+				Migrate: func(ctx sdk.Context, app *IrisApp) error {
+					params := globalfeetypes.DefaultParams()
+					setDefaultParams(ctx, app.GetSubspace(globalfeetypes.ModuleName), &params)
+					return app.globalFeeKeeper.GetParams(ctx).Validate()
+				},
+			},
+		},
+	},
+}
+
+// registerUpgrades sets the upgrade handlers of the given upgrades and the store loader of the one
+// the chain halted for. It must be called before the latest version is loaded.
+func (app *IrisApp) registerUpgrades(upgrades []Upgrade) {
+	names := make(map[string]bool, len(upgrades))
+	for _, u := range upgrades {
+		if len(u.Name) == 0 {
+			panic("upgrade name cannot be empty")
+		}
+		if names[u.Name] {
+			panic(fmt.Sprintf("duplicate upgrade %s", u.Name))
+		}
+		names[u.Name] = true
+
+		for _, m := range u.Migrations {
+			if _, ok := app.mm.Modules[m.Module]; !ok {
+				panic(fmt.Sprintf("upgrade %s migrates unknown module %s", u.Name, m.Module))
+			}
+		}
+
+		app.upgradeKeeper.SetUpgradeHandler(u.Name, app.upgradeHandler(u))
+	}
+
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+	if len(upgradeInfo.Name) == 0 || app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for i := range upgrades {
+		if upgrades[i].Name == upgradeInfo.Name {
+			app.SetStoreLoader(upgradeStoreLoader(upgradeInfo.Height, &upgrades[i].StoreUpgrades))
+			return
+		}
+	}
+}
+
+// upgradeHandler returns the handler running the migrations of the given upgrade
+func (app *IrisApp) upgradeHandler(u Upgrade) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan) {
+		for _, m := range u.Migrations {
+			ctx.Logger().Info(fmt.Sprintf("migrating module %s for upgrade %s", m.Module, plan.Name))
+			if err := m.Migrate(ctx, app); err != nil {
+				panic(fmt.Sprintf("failed to migrate module %s for upgrade %s: %s", m.Module, plan.Name, err))
+			}
+		}
+	}
+}
+
+// upgradeStoreLoader applies the store upgrades when the multistore is loaded right before the upgrade
+// height. Unlike upgradetypes.UpgradeStoreLoader it also applies store additions, which need the
+// initial version set by LoadLatestVersionAndUpgrade, and it loads ms first to read its latest version.
+func upgradeStoreLoader(upgradeHeight int64, storeUpgrades *storetypes.StoreUpgrades) baseapp.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		if err := baseapp.DefaultStoreLoader(ms); err != nil {
+			return err
+		}
+
+		if upgradeHeight == ms.LastCommitID().Version+1 &&
+			(len(storeUpgrades.Added) > 0 || len(storeUpgrades.Renamed) > 0 || len(storeUpgrades.Deleted) > 0) {
+			return ms.LoadLatestVersionAndUpgrade(storeUpgrades)
+		}
+
+		return nil
+	}
+}
+
+// setDefaultParams sets the params of the given subspace missing from the store to the given
+// defaults, keeping the params which are already set
+func setDefaultParams(ctx sdk.Context, subspace paramstypes.Subspace, defaults paramstypes.ParamSet) {
+	for _, pair := range defaults.ParamSetPairs() {
+		if !subspace.Has(ctx, pair.Key) {
+			subspace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	globalfeetypes "github.com/irisnet/irishub/modules/globalfee/types"
+	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+const testUpgradeName = "v2"
+
+var (
+	testKey  = []byte("key")
+	testVal  = []byte("value")
+	testTime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// newUpgradeTestApp returns an app mounting the given stores next to the module stores
+func newUpgradeTestApp(db dbm.DB, home string, keys ...*sdk.KVStoreKey) *IrisApp {
+	mountStores := func(bapp *baseapp.BaseApp) {
+		for _, key := range keys {
+			bapp.MountStores(key)
+		}
+	}
+
+	return NewIrisApp(
+		log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, simapp.FlagPeriodValue,
+		MakeEncodingConfig(), EmptyAppOptions{}, mountStores,
+	)
+}
+
+func beginBlock(app *IrisApp, height int64) sdk.Context {
+	header := tmproto.Header{Height: height, Time: testTime.Add(time.Duration(height) * time.Minute)}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	return app.BaseApp.NewContext(false, header)
+}
+
+func endBlock(app *IrisApp, height int64) {
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+}
+
+func TestUpgrade(t *testing.T) {
+	db := dbm.NewMemDB()
+	home := t.TempDir()
+
+	legacyKey, obsoleteKey := sdk.NewKVStoreKey("legacy"), sdk.NewKVStoreKey("obsolete")
+	addedKey, renamedKey := sdk.NewKVStoreKey("added"), sdk.NewKVStoreKey("renamed")
+
+	// the old binary mounts a legacy and an obsolete store
+	oldApp := newUpgradeTestApp(db, home, legacyKey, obsoleteKey)
+
+	stateBytes, err := json.Marshal(NewDefaultGenesisState())
+	require.NoError(t, err)
+	oldApp.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := beginBlock(oldApp, 1)
+	ctx.KVStore(legacyKey).Set(testKey, testVal)
+	ctx.KVStore(obsoleteKey).Set(testKey, testVal)
+	require.NoError(t, oldApp.upgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: testUpgradeName, Height: 3}))
+	endBlock(oldApp, 1)
+
+	beginBlock(oldApp, 2)
+	endBlock(oldApp, 2)
+
+	// the old binary halts at the upgrade height and dumps the upgrade info
+	require.Panics(t, func() { beginBlock(oldApp, 3) })
+
+	upgradeInfo, err := oldApp.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	require.NoError(t, err)
+	require.Equal(t, storetypes.UpgradeInfo{Name: testUpgradeName, Height: 3}, upgradeInfo)
+
+	var migrated []string
+	defer func(registry []Upgrade) { upgrades = registry }(upgrades)
+	upgrades = []Upgrade{{
+		Name: testUpgradeName,
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added:   []string{"added"},
+			Renamed: []storetypes.StoreRename{{OldKey: "legacy", NewKey: "renamed"}},
+			Deleted: []string{"obsolete"},
+		},
+		Migrations: []Migration{
+			{
+				Module: guardiantypes.ModuleName,
+				Migrate: func(ctx sdk.Context, app *IrisApp) error {
+					migrated = append(migrated, guardiantypes.ModuleName)
+					ctx.KVStore(addedKey).Set(testKey, testVal)
+					return nil
+				},
+			},
+			{
+				Module: minttypes.ModuleName,
+				Migrate: func(ctx sdk.Context, app *IrisApp) error {
+					migrated = append(migrated, minttypes.ModuleName)
+					return nil
+				},
+			},
+		},
+	}}
+
+	// the new binary upgrades the stores on start and runs the migrations at the upgrade height
+	newApp := newUpgradeTestApp(db, home, addedKey, renamedKey, obsoleteKey)
+	require.Equal(t, int64(2), newApp.LastBlockHeight())
+
+	ctx = beginBlock(newApp, 3)
+	require.Equal(t, []string{guardiantypes.ModuleName, minttypes.ModuleName}, migrated)
+	require.Equal(t, int64(3), newApp.upgradeKeeper.GetDoneHeight(ctx, testUpgradeName))
+	endBlock(newApp, 3)
+
+	ctx = newApp.BaseApp.NewContext(true, tmproto.Header{Height: 3})
+	require.Equal(t, testVal, ctx.KVStore(addedKey).Get(testKey))
+	require.Equal(t, testVal, ctx.KVStore(renamedKey).Get(testKey))
+	require.Nil(t, ctx.KVStore(obsoleteKey).Get(testKey))
+
+	// the stores are upgraded only once
+	restartedApp := newUpgradeTestApp(db, home, addedKey, renamedKey, obsoleteKey)
+	require.Equal(t, newApp.LastCommitID(), restartedApp.LastCommitID())
+
+	beginBlock(restartedApp, 4)
+	endBlock(restartedApp, 4)
+	require.Len(t, migrated, 2)
+}
+
+func TestUpgradeDefaultParams(t *testing.T) {
+	db := dbm.NewMemDB()
+	home := t.TempDir()
+
+	// the old binary knows no upgrade
+	registry := upgrades
+	upgrades = nil
+	oldApp := newUpgradeTestApp(db, home)
+	upgrades = registry
+
+	stateBytes, err := json.Marshal(NewDefaultGenesisState())
+	require.NoError(t, err)
+	oldApp.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := beginBlock(oldApp, 1)
+	require.NoError(t, oldApp.upgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: "v1.1", Height: 3}))
+	endBlock(oldApp, 1)
+
+	// the chain started with the inflation and the mint denom as the only mint params, and
+	// without guardian and globalfee params
+	ctx = beginBlock(oldApp, 2)
+	oldApp.EndBlock(abci.RequestEndBlock{Height: 2})
+
+	inflation := sdk.NewDecWithPrec(15, 2)
+	oldApp.GetSubspace(minttypes.ModuleName).Set(ctx, minttypes.KeyInflation, inflation)
+
+	paramStore := ctx.KVStore(oldApp.GetKey(paramstypes.StoreKey))
+	deleteParams := func(subspace string, params paramstypes.ParamSet, kept ...[]byte) {
+		store := prefix.NewStore(paramStore, []byte(subspace+"/"))
+		for _, pair := range params.ParamSetPairs() {
+			if !containsKey(kept, pair.Key) {
+				store.Delete(pair.Key)
+			}
+		}
+	}
+	deleteParams(minttypes.ModuleName, &minttypes.Params{}, minttypes.KeyInflation, minttypes.KeyMintDenom)
+	deleteParams(guardiantypes.ModuleName, &guardiantypes.Params{})
+	deleteParams(globalfeetypes.ModuleName, &globalfeetypes.Params{})
+
+	// the supers were added without roles
+	genesisSuper, ordinarySuper := sdk.AccAddress("genesis_super_______"), sdk.AccAddress("ordinary_super______")
+	oldApp.guardianKeeper.AddSuper(ctx, guardiantypes.NewSuper("genesis", guardiantypes.Genesis, genesisSuper, genesisSuper))
+	oldApp.guardianKeeper.AddSuper(ctx, guardiantypes.NewSuper("ordinary", guardiantypes.Ordinary, ordinarySuper, genesisSuper))
+	oldApp.Commit()
+
+	require.Panics(t, func() { beginBlock(oldApp, 3) })
+
+	// the new binary sets the missing params at the upgrade height and keeps producing blocks
+	newApp := newUpgradeTestApp(db, home)
+	for height := int64(3); height <= 4; height++ {
+		beginBlock(newApp, height)
+		endBlock(newApp, height)
+	}
+
+	ctx = newApp.BaseApp.NewContext(true, tmproto.Header{Height: 4})
+	require.Equal(t, int64(3), newApp.upgradeKeeper.GetDoneHeight(ctx, "v1.1"))
+
+	mintParams := minttypes.DefaultParams()
+	mintParams.Inflation = inflation
+	require.Equal(t, mintParams, newApp.mintKeeper.GetParamSet(ctx))
+	require.Equal(t, guardiantypes.DefaultParams(), newApp.guardianKeeper.GetParams(ctx))
+	require.Equal(t, globalfeetypes.DefaultParams(), newApp.globalFeeKeeper.GetParams(ctx))
+
+	// the ordinary super keeps operating the oracle
+	authorizer := guardiankeeper.NewRoleAuthorizer(newApp.guardianKeeper, guardiantypes.RoleOracleOperator)
+	require.True(t, authorizer.Authorized(ctx, ordinarySuper))
+	require.True(t, authorizer.Authorized(ctx, genesisSuper))
+}
+
+func containsKey(keys [][]byte, key []byte) bool {
+	for _, k := range keys {
+		if string(k) == string(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestUpgradeFailedMigration(t *testing.T) {
+	db := dbm.NewMemDB()
+	home := t.TempDir()
+
+	defer func(registry []Upgrade) { upgrades = registry }(upgrades)
+	upgrades = []Upgrade{{
+		Name: testUpgradeName,
+		Migrations: []Migration{{
+			Module: guardiantypes.ModuleName,
+			Migrate: func(ctx sdk.Context, app *IrisApp) error {
+				return errors.New("migration failed")
+			},
+		}},
+	}}
+
+	app := newUpgradeTestApp(db, home)
+
+	stateBytes, err := json.Marshal(NewDefaultGenesisState())
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := beginBlock(app, 1)
+	require.NoError(t, app.upgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: testUpgradeName, Height: 2}))
+	endBlock(app, 1)
+
+	require.Panics(t, func() { beginBlock(app, 2) })
+}
+
+func TestRegisterUpgradesInvalid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		upgrades []Upgrade
+	}{
+		{"empty name", []Upgrade{{Name: ""}}},
+		{"duplicate name", []Upgrade{{Name: testUpgradeName}, {Name: testUpgradeName}}},
+		{"unknown module", []Upgrade{{Name: testUpgradeName, Migrations: []Migration{{Module: "unknown"}}}}},
+	}
+
+	defer func(registry []Upgrade) { upgrades = registry }(upgrades)
+	for _, tc := range testCases {
+		upgrades = tc.upgrades
+		require.Panics(t, func() { newUpgradeTestApp(dbm.NewMemDB(), t.TempDir()) }, tc.name)
+	}
+}
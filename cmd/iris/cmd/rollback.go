@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/irisnet/irishub/app"
+)
+
+const (
+	flagHeights = "heights"
+	flagDryRun  = "dry-run"
+)
+
+// RollbackCmd returns the rollback cobra Command.
+func RollbackCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rollback",
+		Aliases: []string{"replay"},
+		Short:   "Roll the application and Tendermint state back by a number of heights",
+		Long: `Roll the application multistore, the Tendermint state and the block store back by a
+number of heights, e.g. to recover from an app hash mismatch. Once the node is restarted the
+rolled back blocks are fetched from the network and executed again.
+
+The rollback runs as a dry run by default and only prints the plan; pass --dry-run=false to
+apply it. The node must be stopped, and the validator signing state is left untouched.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			heights, _ := cmd.Flags().GetInt64(flagHeights)
+			dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+
+			plan, err := app.Rollback(config, heights, dryRun)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(plan.String())
+			if dryRun {
+				cmd.Println("Dry run, nothing was written. Pass --dry-run=false to roll back.")
+				return nil
+			}
+
+			cmd.Printf("Rolled back to height %d\n", plan.TargetHeight)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagHeights, 1, "Number of heights to roll back")
+	cmd.Flags().Bool(flagDryRun, true, "Only print the rollback plan without writing anything")
+
+	return cmd
+}
@@ -0,0 +1,23 @@
+package globalfee
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/globalfee/keeper"
+	"github.com/irisnet/irishub/modules/globalfee/types"
+)
+
+// InitGenesis stores the genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize globalfee genesis state: %s", err.Error()))
+	}
+	k.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis outputs the genesis state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
@@ -0,0 +1,60 @@
+package globalfee_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/globalfee"
+	"github.com/irisnet/irishub/modules/globalfee/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (suite *TestSuite) TestExportGenesis() {
+	exportedGenesis := globalfee.ExportGenesis(suite.ctx, suite.app.GlobalFeeKeeper)
+	suite.Equal(types.DefaultGenesisState(), exportedGenesis)
+}
+
+func (suite *TestSuite) TestInitExportGenesis() {
+	genesis := types.NewGenesisState(types.NewParams(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(2, 1))),
+		[]types.FeeToken{types.NewFeeToken("ueth", sdk.NewDec(3))},
+	))
+	suite.NoError(types.ValidateGenesis(*genesis))
+
+	globalfee.InitGenesis(suite.ctx, suite.app.GlobalFeeKeeper, *genesis)
+	exportedGenesis := globalfee.ExportGenesis(suite.ctx, suite.app.GlobalFeeKeeper)
+	suite.Equal(genesis, exportedGenesis)
+}
+
+func (suite *TestSuite) TestInitGenesisInvalid() {
+	// the fee tokens only count towards the min gas price of the native token
+	genesis := types.NewGenesisState(types.NewParams(
+		sdk.NewDecCoins(sdk.NewDecCoin("atom", sdk.OneInt())),
+		[]types.FeeToken{types.NewFeeToken("ueth", sdk.NewDec(3))},
+	))
+	suite.Error(types.ValidateGenesis(*genesis))
+	suite.Panics(func() { globalfee.InitGenesis(suite.ctx, suite.app.GlobalFeeKeeper, *genesis) })
+}
@@ -0,0 +1,29 @@
+package globalfee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/globalfee/keeper"
+)
+
+// NewParamChangeProposalHandler wraps the handler of the param change proposals, so that the
+// proposals leaving the globalfee params inconsistent, e.g. setting the fee tokens without a
+// min gas price of the native token, fail
+func NewParamChangeProposalHandler(k keeper.Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := paramsHandler(cacheCtx, content); err != nil {
+			return err
+		}
+
+		if err := k.GetParams(cacheCtx).Validate(); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return nil
+	}
+}
@@ -0,0 +1,36 @@
+package globalfee_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/irisnet/irishub/modules/globalfee"
+	"github.com/irisnet/irishub/modules/globalfee/types"
+)
+
+func (suite *TestSuite) TestParamChangeProposalHandler() {
+	app, ctx := suite.app, suite.ctx
+	handler := globalfee.NewParamChangeProposalHandler(app.GlobalFeeKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+
+	newProposal := func(changes ...paramproposal.ParamChange) *paramproposal.ParameterChangeProposal {
+		return paramproposal.NewParameterChangeProposal("title", "description", changes)
+	}
+	feeTokens := paramproposal.NewParamChange(types.ModuleName, string(types.KeyFeeTokens), `[{"denom":"ueth","rate":"3"}]`)
+
+	// the fee tokens can't be set without a min gas price of the native token
+	suite.Error(handler(ctx, newProposal(feeTokens)))
+	suite.Empty(app.GlobalFeeKeeper.GetParams(ctx).FeeTokens)
+
+	suite.NoError(handler(ctx, newProposal(
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMinGasPrices), `[{"denom":"`+sdk.DefaultBondDenom+`","amount":"0.2"}]`),
+		feeTokens,
+	)))
+	suite.Equal([]types.FeeToken{types.NewFeeToken("ueth", sdk.NewDec(3))}, app.GlobalFeeKeeper.GetParams(ctx).FeeTokens)
+
+	// nor can the min gas price of the native token be removed while the fee tokens are set
+	suite.Error(handler(ctx, newProposal(
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMinGasPrices), `[{"denom":"atom","amount":"0.2"}]`),
+	)))
+	suite.Equal(sdk.NewDecWithPrec(2, 1), app.GlobalFeeKeeper.GetParams(ctx).MinGasPrices.AmountOf(sdk.DefaultBondDenom))
+}
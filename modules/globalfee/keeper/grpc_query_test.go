@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/globalfee/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryParams() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GlobalFeeKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	paramsResp, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(types.DefaultParams(), paramsResp.Params)
+
+	params := types.NewParams(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(2, 1))),
+		[]types.FeeToken{types.NewFeeToken("ueth", sdk.NewDec(3))},
+	)
+	app.GlobalFeeKeeper.SetParams(ctx, params)
+
+	paramsResp, err = queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(params, paramsResp.Params)
+
+	_, err = app.GlobalFeeKeeper.Params(gocontext.Background(), nil)
+	suite.Require().Error(err)
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/globalfee/types"
+)
+
+// Keeper of the globalfee params
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper returns a globalfee keeper
+func NewKeeper(paramSpace paramtypes.Subspace) Keeper {
+	return Keeper{
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// GetParams returns the total set of globalfee parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the globalfee parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/globalfee/keeper"
+	"github.com/irisnet/irishub/modules/globalfee/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	app    *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.keeper = app.GlobalFeeKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestSetGetParams() {
+	suite.Equal(types.DefaultParams(), suite.keeper.GetParams(suite.ctx))
+
+	params := types.NewParams(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(2, 1))),
+		[]types.FeeToken{types.NewFeeToken("ueth", sdk.NewDec(3))},
+	)
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Equal(params, suite.keeper.GetParams(suite.ctx))
+}
@@ -0,0 +1,25 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/irisnet/irishub/modules/globalfee/types"
+)
+
+// RandomizedGenState generates a GenesisState for globalfee, the global min gas prices are
+// left unset since the simulated txs pay random fees
+func RandomizedGenState(simState *module.SimulationState) {
+	globalFeeGenesis := types.DefaultGenesisState()
+
+	bz, err := json.MarshalIndent(&globalFeeGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(globalFeeGenesis)
+}
@@ -0,0 +1,632 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: globalfee/globalfee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// globalfee parameters
+type Params struct {
+	// minimum gas prices enforced on all the txs, a tx is accepted if its fee covers the price of any denom
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices" yaml:"min_gas_prices"`
+	// tokens of the token module accepted in place of the native token, they only count towards the
+	// min gas price of the native token which must be set if any fee token is
+	FeeTokens []FeeToken `protobuf:"bytes,2,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens" yaml:"fee_tokens"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c23c19cca1c8471, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+// FeeToken defines a token accepted for the fees at a conversion rate
+type FeeToken struct {
+	// min unit of the token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount of the native token min unit worth one min unit of the token
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c23c19cca1c8471, []int{1}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "irishub.globalfee.Params")
+	proto.RegisterType((*FeeToken)(nil), "irishub.globalfee.FeeToken")
+}
+
+func init() { proto.RegisterFile("globalfee/globalfee.proto", fileDescriptor_3c23c19cca1c8471) }
+
+var fileDescriptor_3c23c19cca1c8471 = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x3f, 0x6f, 0xda, 0x40,
+	0x14, 0xf7, 0x51, 0x8a, 0xca, 0xb5, 0xaa, 0x84, 0x45, 0x55, 0x43, 0x2b, 0x1b, 0x79, 0xa8, 0x90,
+	0xaa, 0x9e, 0x05, 0xdd, 0x18, 0xdd, 0xaa, 0x5d, 0x18, 0x90, 0x95, 0x2c, 0x59, 0xd0, 0xd9, 0x7e,
+	0x38, 0x27, 0x7c, 0x3e, 0xe4, 0x33, 0x91, 0xf8, 0x16, 0x8c, 0x19, 0x33, 0xe7, 0x93, 0x30, 0x32,
+	0x46, 0x19, 0x48, 0x04, 0xdf, 0x20, 0x53, 0xc6, 0xc8, 0x3e, 0x03, 0x89, 0xb2, 0x64, 0xba, 0x77,
+	0xef, 0xf7, 0x47, 0xbf, 0x9f, 0x1e, 0x6e, 0x45, 0xb1, 0xf0, 0x69, 0x3c, 0x01, 0x70, 0x0e, 0x13,
+	0x99, 0xa5, 0x22, 0x13, 0x7a, 0x83, 0xa5, 0x4c, 0x9e, 0xcf, 0x7d, 0x72, 0x00, 0xda, 0xcd, 0x48,
+	0x44, 0xa2, 0x40, 0x9d, 0x7c, 0x52, 0xc4, 0xf6, 0xd7, 0x40, 0x48, 0x2e, 0xe4, 0x58, 0x01, 0x81,
+	0x60, 0x89, 0x02, 0xec, 0x47, 0x84, 0x6b, 0x23, 0x9a, 0x52, 0x2e, 0xf5, 0x25, 0xc2, 0x9f, 0x39,
+	0x4b, 0xc6, 0x11, 0xcd, 0x79, 0x2c, 0x00, 0x69, 0xa0, 0xce, 0xbb, 0xee, 0xc7, 0xfe, 0x77, 0xa2,
+	0xd4, 0xc4, 0xa7, 0x12, 0xc8, 0x45, 0xcf, 0x87, 0x8c, 0xf6, 0xc8, 0x5f, 0x08, 0xfe, 0x08, 0x96,
+	0xb8, 0xc3, 0xd5, 0xc6, 0xd2, 0x1e, 0x36, 0xd6, 0x97, 0x05, 0xe5, 0xf1, 0xc0, 0x7e, 0xe9, 0x60,
+	0x5f, 0xdf, 0x59, 0x3f, 0x23, 0x96, 0xe5, 0x19, 0x03, 0xc1, 0x1d, 0x65, 0x54, 0x3e, 0xbf, 0x64,
+	0x38, 0x75, 0xb2, 0xc5, 0x0c, 0xe4, 0xde, 0x4c, 0x7a, 0x9f, 0x38, 0x4b, 0xfe, 0x53, 0x39, 0x2a,
+	0xd4, 0xfa, 0x29, 0xc6, 0x13, 0x80, 0x71, 0x26, 0xa6, 0x90, 0x48, 0xa3, 0x52, 0xa4, 0xf9, 0x46,
+	0x5e, 0x95, 0x26, 0xff, 0x00, 0x4e, 0x72, 0x8e, 0xdb, 0x2a, 0xc3, 0x34, 0x54, 0x98, 0xa3, 0xd8,
+	0xf6, 0xea, 0x93, 0x92, 0x24, 0x07, 0xd5, 0xcb, 0x2b, 0x4b, 0xb3, 0x43, 0xfc, 0x61, 0xaf, 0xd3,
+	0x9b, 0xf8, 0x7d, 0x08, 0x89, 0xe0, 0x06, 0xea, 0xa0, 0x6e, 0xdd, 0x53, 0x1f, 0xdd, 0xc5, 0xd5,
+	0x94, 0x66, 0x60, 0x54, 0xf2, 0xa5, 0x4b, 0x72, 0xef, 0xdb, 0x8d, 0xf5, 0xe3, 0x6d, 0x7d, 0xbc,
+	0x42, 0xeb, 0x0e, 0x57, 0x5b, 0x13, 0xad, 0xb7, 0x26, 0xba, 0xdf, 0x9a, 0x68, 0xb9, 0x33, 0xb5,
+	0xf5, 0xce, 0xd4, 0x6e, 0x76, 0xa6, 0x76, 0xd6, 0x7f, 0xe6, 0x93, 0x57, 0x4a, 0x20, 0x73, 0xca,
+	0x6a, 0x0e, 0x17, 0xe1, 0x3c, 0x06, 0x79, 0x3c, 0xb8, 0xf2, 0xf5, 0x6b, 0xc5, 0xd5, 0x7e, 0x3f,
+	0x0d, 0x00, 0xe7, 0x3a, 0x61, 0x01, 0x14, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGlobalfee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGlobalfee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGlobalfee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGlobalfee(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGlobalfee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGlobalfee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGlobalfee(uint64(l))
+		}
+	}
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovGlobalfee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGlobalfee(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovGlobalfee(uint64(l))
+	return n
+}
+
+func sovGlobalfee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGlobalfee(x uint64) (n int) {
+	return sovGlobalfee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobalfee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobalfee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobalfee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobalfee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGlobalfee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGlobalfee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGlobalfee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGlobalfee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGlobalfee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGlobalfee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGlobalfee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGlobalfee = fmt.Errorf("proto: unexpected end of group")
+)
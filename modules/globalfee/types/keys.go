@@ -0,0 +1,7 @@
+package types
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "globalfee"
+)
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+// Parameter store key
+var (
+	KeyMinGasPrices = []byte("MinGasPrices")
+	KeyFeeTokens    = []byte("FeeTokens")
+)
+
+// ParamKeyTable for globalfee module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(minGasPrices sdk.DecCoins, feeTokens []FeeToken) Params {
+	return Params{
+		MinGasPrices: minGasPrices,
+		FeeTokens:    feeTokens,
+	}
+}
+
+// DefaultParams returns default globalfee module parameters,
+// no global min gas price is enforced until set by governance
+func DefaultParams() Params {
+	return NewParams(nil, nil)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinGasPrices, &p.MinGasPrices, validateMinGasPrices),
+		paramtypes.NewParamSetPair(KeyFeeTokens, &p.FeeTokens, validateFeeTokens),
+	}
+}
+
+// GetFeeToken returns the fee token of the given denom
+func (p Params) GetFeeToken(denom string) (FeeToken, bool) {
+	for _, token := range p.FeeTokens {
+		if token.Denom == denom {
+			return token, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateMinGasPrices(p.MinGasPrices); err != nil {
+		return err
+	}
+	if err := validateFeeTokens(p.FeeTokens); err != nil {
+		return err
+	}
+
+	// the fee tokens are only converted to pay the min gas price of the native token
+	nativeDenom := tokentypes.GetNativeToken().MinUnit
+	if len(p.FeeTokens) > 0 && p.MinGasPrices.AmountOf(nativeDenom).IsZero() {
+		return fmt.Errorf("fee tokens require a min gas price of the native token %s", nativeDenom)
+	}
+	for _, token := range p.FeeTokens {
+		if !p.MinGasPrices.AmountOf(token.Denom).IsZero() {
+			return fmt.Errorf("fee token %s already has a min gas price", token.Denom)
+		}
+	}
+
+	return nil
+}
+
+// NewFeeToken creates a new FeeToken instance
+func NewFeeToken(denom string, rate sdk.Dec) FeeToken {
+	return FeeToken{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+func validateMinGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateFeeTokens(i interface{}) error {
+	v, ok := i.([]FeeToken)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, token := range v {
+		if err := sdk.ValidateDenom(token.Denom); err != nil {
+			return err
+		}
+		if token.Denom == tokentypes.GetNativeToken().MinUnit {
+			return fmt.Errorf("fee token %s cannot be the native token", token.Denom)
+		}
+		if seen[token.Denom] {
+			return fmt.Errorf("duplicated fee token %s", token.Denom)
+		}
+		seen[token.Denom] = true
+
+		if token.Rate.IsNil() || !token.Rate.IsPositive() {
+			return fmt.Errorf("rate [%s] of fee token %s must be positive", token.Rate, token.Denom)
+		}
+	}
+
+	return nil
+}
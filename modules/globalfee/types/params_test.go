@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(2, 1)))
+	feeToken := NewFeeToken("ueth", sdk.NewDec(3))
+
+	testCases := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"min gas prices", NewParams(minGasPrices, nil), true},
+		{"fee tokens", NewParams(minGasPrices, []FeeToken{feeToken}), true},
+		{"unsorted min gas prices", NewParams(sdk.DecCoins{minGasPrices[0], sdk.NewDecCoin("atom", sdk.OneInt())}, nil), false},
+		{"zero min gas price", NewParams(sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.ZeroInt())}, nil), false},
+		{"invalid fee token denom", NewParams(minGasPrices, []FeeToken{NewFeeToken("e", sdk.OneDec())}), false},
+		{"native fee token", NewParams(minGasPrices, []FeeToken{NewFeeToken(sdk.DefaultBondDenom, sdk.OneDec())}), false},
+		{"duplicated fee token", NewParams(minGasPrices, []FeeToken{feeToken, feeToken}), false},
+		{"nil fee token rate", NewParams(minGasPrices, []FeeToken{NewFeeToken("ueth", sdk.Dec{})}), false},
+		{"zero fee token rate", NewParams(minGasPrices, []FeeToken{NewFeeToken("ueth", sdk.ZeroDec())}), false},
+		{"fee tokens without a native min gas price", NewParams(sdk.NewDecCoins(sdk.NewDecCoin("atom", sdk.OneInt())), []FeeToken{feeToken}), false},
+		{"fee token with a min gas price", NewParams(minGasPrices.Add(sdk.NewDecCoin("ueth", sdk.OneInt())), []FeeToken{feeToken}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package guardian
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// EndBlocker prunes the supers expired and the pending actions past their deadline at the current block
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	logger := k.Logger(ctx)
+	for _, action := range k.PruneExpiredActions(ctx) {
+		logger.Info("Pending action expired", "id", action.Id, "approvals", len(action.Approvals))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireAction,
+				sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", action.Id)),
+				sdk.NewAttribute(types.AttributeKeyActionType, action.ActionType.String()),
+				sdk.NewAttribute(types.AttributeKeySuperAddress, action.Address),
+			),
+		)
+	}
+
+	for _, super := range k.PruneExpiredSupers(ctx) {
+		logger.Info("Super expired", "address", super.Address)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireSuper,
+				sdk.NewAttribute(types.AttributeKeySuperAddress, super.Address),
+				sdk.NewAttribute(types.AttributeKeyExpiryHeight, fmt.Sprintf("%d", super.ExpiryHeight)),
+				sdk.NewAttribute(types.AttributeKeyExpiryTime, expiryTimeString(super)),
+			),
+		)
+	}
+}
+
+func expiryTimeString(super types.Super) string {
+	if super.ExpiryTime == nil {
+		return ""
+	}
+	return super.ExpiryTime.String()
+}
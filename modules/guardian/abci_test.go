@@ -0,0 +1,77 @@
+package guardian_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian"
+	"github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+func TestEndBlockerPruneExpiredSupers(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	addr := sdk.AccAddress("expiring_super______")
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("expiring", types.Ordinary, addr, addr).WithExpiry(nil, 11))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	guardian.EndBlocker(ctx, app.GuardianKeeper)
+	require.Empty(t, ctx.EventManager().Events())
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	guardian.EndBlocker(ctx, app.GuardianKeeper)
+
+	_, found := app.GuardianKeeper.GetSuper(ctx, addr)
+	require.False(t, found)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeExpireSuper, events[0].Type)
+	require.Equal(t, types.AttributeKeySuperAddress, string(events[0].Attributes[0].Key))
+	require.Equal(t, addr.String(), string(events[0].Attributes[0].Value))
+}
+
+func TestEndBlockerPruneExpiredActions(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Unix(1600000000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: now})
+
+	genesisAddr := sdk.AccAddress("genesis_super_______")
+	ordinaryAddr := sdk.AccAddress("ordinary_super______")
+	app.GuardianKeeper.SetParams(ctx, types.NewParams(2, time.Hour, nil, sdk.OneDec(), 10, types.RoleOracleOperator))
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, genesisAddr, genesisAddr))
+
+	id, err := app.GuardianKeeper.SubmitAction(ctx, types.NewDeleteSuperAction(ordinaryAddr, genesisAddr, now.Add(time.Hour)))
+	require.Error(t, err)
+	require.Zero(t, id)
+
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("ordinary", types.Ordinary, ordinaryAddr, genesisAddr))
+	id, err = app.GuardianKeeper.SubmitAction(ctx, types.NewDeleteSuperAction(ordinaryAddr, genesisAddr, now.Add(time.Hour)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	guardian.EndBlocker(ctx, app.GuardianKeeper)
+	require.Empty(t, ctx.EventManager().Events())
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	guardian.EndBlocker(ctx, app.GuardianKeeper)
+
+	_, found := app.GuardianKeeper.GetPendingAction(ctx, id)
+	require.False(t, found)
+	_, found = app.GuardianKeeper.GetSuper(ctx, ordinaryAddr)
+	require.True(t, found)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeExpireAction, events[0].Type)
+	require.Equal(t, fmt.Sprintf("%d", id), string(events[0].Attributes[0].Value))
+}
@@ -8,6 +8,7 @@ import (
 	cosmoscrypto "github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/tendermint/tendermint/crypto"
 
 	guardiancli "github.com/irisnet/irishub/modules/guardian/client/cli"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
 	guardiantestutil "github.com/irisnet/irishub/modules/guardian/client/testutil"
 	"github.com/irisnet/irishub/simapp"
@@ -96,6 +98,8 @@ func (s *IntegrationTestSuite) TestGuardian() {
 	args = []string{
 		fmt.Sprintf("--%s=%s", guardiancli.FlagAddress, from.String()),
 		fmt.Sprintf("--%s=%s", guardiancli.FlagDescription, description),
+		fmt.Sprintf("--%s=%s", guardiancli.FlagRoles, guardiantypes.RoleOracleOperator.Name()),
+		fmt.Sprintf("--%s=1000000", guardiancli.FlagExpiryHeight),
 
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
@@ -118,6 +122,88 @@ func (s *IntegrationTestSuite) TestGuardian() {
 	supersResp = respType.(*guardiantypes.QuerySupersResponse)
 	s.Require().Equal(2, len(supersResp.Supers))
 
+	// the genesis super holds all the roles
+	respType = proto.Message(&guardiantypes.QuerySupersResponse{})
+	bz, err = guardiantestutil.QuerySupersExec(clientCtx, fmt.Sprintf("--%s=%s", guardiancli.FlagRole, guardiantypes.RoleTokenAdmin.Name()))
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType))
+	supersResp = respType.(*guardiantypes.QuerySupersResponse)
+	s.Require().Equal(1, len(supersResp.Supers))
+	s.Require().Equal(addr.String(), supersResp.Supers[0].Address)
+
+	//------test GetCmdSetRoles()-------------
+	args = []string{
+		fmt.Sprintf("--%s=%s", guardiancli.FlagAddress, from.String()),
+		fmt.Sprintf("--%s=%s,%s", guardiancli.FlagRoles, guardiantypes.RoleOracleOperator.Name(), guardiantypes.RoleTokenAdmin.Name()),
+
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	respType = proto.Message(&sdk.TxResponse{})
+
+	bz, err = guardiantestutil.SetRolesExec(val.ClientCtx, addr.String(), args...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	txResp = respType.(*sdk.TxResponse)
+	s.Require().Equal(expectedCode, txResp.Code)
+
+	respType = proto.Message(&guardiantypes.QuerySupersResponse{})
+	bz, err = guardiantestutil.QuerySupersExec(clientCtx, fmt.Sprintf("--%s=%s", guardiancli.FlagRole, guardiantypes.RoleTokenAdmin.Name()))
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType))
+	supersResp = respType.(*guardiantypes.QuerySupersResponse)
+	s.Require().Equal(2, len(supersResp.Supers))
+
+	_, err = guardiantestutil.QuerySupersExec(clientCtx, fmt.Sprintf("--%s=admin", guardiancli.FlagRole))
+	s.Require().Error(err)
+
+	respType = proto.Message(&guardiantypes.QuerySupersResponse{})
+	bz, err = guardiantestutil.QuerySupersExec(clientCtx,
+		fmt.Sprintf("--%s=Ordinary", guardiancli.FlagAccountType),
+		fmt.Sprintf("--%s=%s", guardiancli.FlagAddedBy, addr.String()),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType))
+	supersResp = respType.(*guardiantypes.QuerySupersResponse)
+	s.Require().Equal(1, len(supersResp.Supers))
+	s.Require().Equal(from.String(), supersResp.Supers[0].Address)
+
+	respType = proto.Message(&guardiantypes.QuerySupersResponse{})
+	bz, err = guardiantestutil.QuerySupersExec(clientCtx, fmt.Sprintf("--%s=1", flags.FlagLimit), fmt.Sprintf("--%s=true", flags.FlagCountTotal))
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType))
+	supersResp = respType.(*guardiantypes.QuerySupersResponse)
+	s.Require().Equal(1, len(supersResp.Supers))
+	s.Require().Equal(uint64(2), supersResp.Pagination.Total)
+
+	//------test GetCmdQuerySuper()-------------
+	superType := proto.Message(&guardiantypes.Super{})
+	bz, err = guardiantestutil.QuerySuperExec(clientCtx, from.String())
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), superType))
+	super := superType.(*guardiantypes.Super)
+	s.Require().Equal(addr.String(), super.AddedBy)
+	s.Require().Equal([]guardiantypes.Role{guardiantypes.RoleOracleOperator, guardiantypes.RoleTokenAdmin}, super.Roles)
+	s.Require().Equal(int64(1000000), super.ExpiryHeight)
+	s.Require().Nil(super.ExpiryTime)
+
+	//------test gRPC gateway routes-------------
+	resp, err := rest.GetRequest(fmt.Sprintf("%s/irishub/guardian/supers/%s", val.APIAddress, from.String()))
+	s.Require().NoError(err)
+	superResp := proto.Message(&guardiantypes.QuerySuperResponse{})
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(resp, superResp))
+	s.Require().Equal(*super, superResp.(*guardiantypes.QuerySuperResponse).Super)
+
+	resp, err = rest.GetRequest(fmt.Sprintf("%s/irishub/guardian/supers?account_type=Genesis&pagination.limit=1", val.APIAddress))
+	s.Require().NoError(err)
+	respType = proto.Message(&guardiantypes.QuerySupersResponse{})
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(resp, respType))
+	supersResp = respType.(*guardiantypes.QuerySupersResponse)
+	s.Require().Equal(1, len(supersResp.Supers))
+	s.Require().Equal(addr.String(), supersResp.Supers[0].Address)
+
 	//------test GetCmdDeleteSuper()-------------
 	args = []string{
 		fmt.Sprintf("--%s=%s", guardiancli.FlagAddress, from.String()),
@@ -141,4 +227,263 @@ func (s *IntegrationTestSuite) TestGuardian() {
 	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType))
 	supersResp = respType.(*guardiantypes.QuerySupersResponse)
 	s.Require().Equal(1, len(supersResp.Supers))
+
+	//------test GetCmdQueryAuditLog()-------------
+	auditResp := &guardiantypes.QueryAuditLogResponse{}
+	bz, err = guardiantestutil.QueryAuditLogExec(clientCtx, fmt.Sprintf("--%s=%s", guardiancli.FlagTarget, from.String()))
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), auditResp))
+	s.Require().Len(auditResp.Records, 3)
+	s.Require().Equal(guardiantypes.OperationAddSuper, auditResp.Records[0].Operation)
+	s.Require().Equal(guardiantypes.OperationSetRoles, auditResp.Records[1].Operation)
+	s.Require().Equal(guardiantypes.OperationDeleteSuper, auditResp.Records[2].Operation)
+	s.Require().Equal(addr.String(), auditResp.Records[2].Actor)
+}
+
+func (s *IntegrationTestSuite) TestPendingActions() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	//------test GetCmdQueryParams()-------------
+	params := &guardiantypes.Params{}
+	bz, err := guardiantestutil.QueryParamsExec(clientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), params))
+	// the empty fee exempt msg types are decoded as an empty slice
+	s.Require().Equal(guardiantypes.DefaultParams().String(), params.String())
+
+	//------test GetCmdQueryPendingActions()-------------
+	// a single genesis super approval meets the default threshold, so nothing is pending
+	actionsResp := &guardiantypes.QueryPendingActionsResponse{}
+	bz, err = guardiantestutil.QueryPendingActionsExec(clientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), actionsResp))
+	s.Require().Empty(actionsResp.PendingActions)
+
+	//------test GetCmdApproveAction()-------------
+	respType := proto.Message(&sdk.TxResponse{})
+	bz, err = guardiantestutil.ApproveActionExec(clientCtx, val.Address.String(), "1",
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(guardiantypes.ErrUnknownOperator.ABCICode(), respType.(*sdk.TxResponse).Code)
+
+	_, err = guardiantestutil.ApproveActionExec(clientCtx, val.Address.String(), "invalid")
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestSubmitSuperProposals() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	_, _, superAddr := testdata.KeyTestPubAddr()
+
+	// the proposal contents are checked when submitted, so the super to delete must exist
+	privKeyStr := cosmoscrypto.EncryptArmorPrivKey(privKey, "", "")
+	_ = clientCtx.Keyring.ImportPrivKey(addr.String(), privKeyStr, "")
+
+	args := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	_, err := banktestutil.MsgSendExec(clientCtx, val.Address, addr, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100000000)), args...)
+	s.Require().NoError(err)
+
+	_, err = guardiantestutil.CreateSuperExec(clientCtx, addr.String(), append(args,
+		fmt.Sprintf("--%s=%s", guardiancli.FlagAddress, superAddr.String()),
+		fmt.Sprintf("--%s=%s", guardiancli.FlagDescription, "ordinary"),
+	)...)
+	s.Require().NoError(err)
+
+	txArgs := []string{
+		fmt.Sprintf("--%s=%s", govcli.FlagTitle, "Guardian membership"),
+		fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Update the guardian membership"),
+		fmt.Sprintf("--%s=%s", govcli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)).String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	//------test GetCmdSubmitAddSuperProposal()-------------
+	respType := proto.Message(&sdk.TxResponse{})
+	_, _, genesisAddr := testdata.KeyTestPubAddr()
+	bz, err := guardiantestutil.SubmitAddSuperProposalExec(clientCtx, val.Address.String(), append(txArgs,
+		genesisAddr.String(), "new genesis",
+		fmt.Sprintf("--%s=Genesis", guardiancli.FlagAccountType),
+		fmt.Sprintf("--%s=%s", guardiancli.FlagRoles, guardiantypes.RoleOracleOperator.Name()),
+	)...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(uint32(0), respType.(*sdk.TxResponse).Code)
+
+	_, err = guardiantestutil.SubmitAddSuperProposalExec(clientCtx, val.Address.String(), append(txArgs,
+		genesisAddr.String(), "new genesis", fmt.Sprintf("--%s=Admin", guardiancli.FlagAccountType),
+	)...)
+	s.Require().Error(err)
+
+	//------test GetCmdSubmitDeleteSuperProposal()-------------
+	respType = proto.Message(&sdk.TxResponse{})
+	bz, err = guardiantestutil.SubmitDeleteSuperProposalExec(clientCtx, val.Address.String(), append(txArgs, superAddr.String())...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(uint32(0), respType.(*sdk.TxResponse).Code)
+
+	//------test GetCmdSubmitChangeSuperTypeProposal()-------------
+	respType = proto.Message(&sdk.TxResponse{})
+	bz, err = guardiantestutil.SubmitChangeSuperTypeProposalExec(clientCtx, val.Address.String(), append(txArgs, superAddr.String(), "Ordinary")...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(uint32(0), respType.(*sdk.TxResponse).Code)
+
+	_, err = guardiantestutil.SubmitChangeSuperTypeProposalExec(clientCtx, val.Address.String(), append(txArgs, "invalid", "Ordinary")...)
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestUpdateSuper() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	privKeyStr := cosmoscrypto.EncryptArmorPrivKey(privKey, "", "")
+	_ = clientCtx.Keyring.ImportPrivKey(addr.String(), privKeyStr, "")
+
+	args := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	_, err := banktestutil.MsgSendExec(clientCtx, val.Address, addr, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100000000)), args...)
+	s.Require().NoError(err)
+
+	//------test GetCmdUpdateSuper()-------------
+	respType := proto.Message(&sdk.TxResponse{})
+	bz, err := guardiantestutil.UpdateSuperExec(clientCtx, addr.String(), append(args,
+		fmt.Sprintf("--%s=%s", guardiancli.FlagWebsite, "https://irisnet.org"),
+		fmt.Sprintf("--%s=%s", guardiancli.FlagSecurityContact, "security@irisnet.org"),
+	)...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(uint32(0), respType.(*sdk.TxResponse).Code)
+
+	super := &guardiantypes.Super{}
+	bz, err = guardiantestutil.QuerySuperExec(clientCtx, addr.String())
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), super))
+	s.Require().Equal("test", super.Description)
+	s.Require().Equal("https://irisnet.org", super.Website)
+	s.Require().Equal("security@irisnet.org", super.SecurityContact)
+
+	// a non-super can't update
+	respType = proto.Message(&sdk.TxResponse{})
+	bz, err = guardiantestutil.UpdateSuperExec(clientCtx, val.Address.String(), append(args,
+		fmt.Sprintf("--%s=%s", guardiancli.FlagDescription, "validator"),
+	)...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(guardiantypes.ErrUnknownSuper.ABCICode(), respType.(*sdk.TxResponse).Code)
+}
+
+func (s *IntegrationTestSuite) TestCircuitBreaker() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	msgTypeURL := "/irismod.token.MsgMintToken"
+
+	privKeyStr := cosmoscrypto.EncryptArmorPrivKey(privKey, "", "")
+	_ = clientCtx.Keyring.ImportPrivKey(addr.String(), privKeyStr, "")
+
+	args := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	_, err := banktestutil.MsgSendExec(clientCtx, val.Address, addr, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100000000)), args...)
+	s.Require().NoError(err)
+
+	//------test GetCmdDisableMsgTypes()-------------
+	respType := proto.Message(&sdk.TxResponse{})
+	bz, err := guardiantestutil.DisableMsgTypesExec(clientCtx, addr.String(), msgTypeURL, args...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(uint32(0), respType.(*sdk.TxResponse).Code)
+
+	//------test GetCmdQueryDisabledMsgTypes()-------------
+	res := &guardiantypes.QueryDisabledMsgTypesResponse{}
+	bz, err = guardiantestutil.QueryDisabledMsgTypesExec(clientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), res))
+	s.Require().Equal([]string{msgTypeURL}, res.MsgTypeUrls)
+
+	// a non-super can't re-enable
+	respType = proto.Message(&sdk.TxResponse{})
+	bz, err = guardiantestutil.EnableMsgTypesExec(clientCtx, val.Address.String(), msgTypeURL, args...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(guardiantypes.ErrUnknownOperator.ABCICode(), respType.(*sdk.TxResponse).Code)
+
+	//------test GetCmdEnableMsgTypes()-------------
+	respType = proto.Message(&sdk.TxResponse{})
+	bz, err = guardiantestutil.EnableMsgTypesExec(clientCtx, addr.String(), msgTypeURL, args...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(uint32(0), respType.(*sdk.TxResponse).Code)
+
+	res = &guardiantypes.QueryDisabledMsgTypesResponse{}
+	bz, err = guardiantestutil.QueryDisabledMsgTypesExec(clientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), res))
+	s.Require().Empty(res.MsgTypeUrls)
+}
+
+func (s *IntegrationTestSuite) TestFreezeAccount() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	frozenAddr := sdk.AccAddress("frozen______________")
+
+	privKeyStr := cosmoscrypto.EncryptArmorPrivKey(privKey, "", "")
+	_ = clientCtx.Keyring.ImportPrivKey(addr.String(), privKeyStr, "")
+
+	args := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	_, err := banktestutil.MsgSendExec(clientCtx, val.Address, addr, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100000000)), args...)
+	s.Require().NoError(err)
+
+	//------test GetCmdFreezeAccount()-------------
+	respType := proto.Message(&sdk.TxResponse{})
+	bz, err := guardiantestutil.FreezeAccountExec(clientCtx, addr.String(), frozenAddr.String(), append(args,
+		fmt.Sprintf("--%s=%s", guardiancli.FlagReason, "stolen"),
+	)...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(uint32(0), respType.(*sdk.TxResponse).Code)
+
+	//------test GetCmdQueryFrozenAccount()-------------
+	account := &guardiantypes.FrozenAccount{}
+	bz, err = guardiantestutil.QueryFrozenAccountExec(clientCtx, frozenAddr.String())
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), account))
+	s.Require().Equal(addr.String(), account.FrozenBy)
+	s.Require().Equal("stolen", account.Reason)
+
+	// the operator can't freeze itself
+	_, err = guardiantestutil.FreezeAccountExec(clientCtx, addr.String(), addr.String(), args...)
+	s.Require().Error(err)
+
+	//------test GetCmdUnfreezeAccount()-------------
+	respType = proto.Message(&sdk.TxResponse{})
+	bz, err = guardiantestutil.UnfreezeAccountExec(clientCtx, addr.String(), frozenAddr.String(), args...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(uint32(0), respType.(*sdk.TxResponse).Code)
+
+	//------test GetCmdQueryFrozenAccounts()-------------
+	res := &guardiantypes.QueryFrozenAccountsResponse{}
+	bz, err = guardiantestutil.QueryFrozenAccountsExec(clientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), res))
+	s.Require().Empty(res.FrozenAccounts)
 }
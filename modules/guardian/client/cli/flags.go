@@ -3,21 +3,44 @@ package cli
 
 import (
 	flag "github.com/spf13/pflag"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
 )
 
 const (
-	FlagAddress     = "address"
-	FlagDescription = "description"
+	FlagAddress      = "address"
+	FlagDescription  = "description"
+	FlagRoles        = "roles"
+	FlagRole         = "role"
+	FlagAccountType  = "account-type"
+	FlagAddedBy      = "added-by"
+	FlagExpiryTime   = "expiry-time"
+	FlagExpiryHeight = "expiry-height"
+
+	FlagWebsite         = "website"
+	FlagSecurityContact = "security-contact"
+	FlagTarget          = "target"
+	FlagReason          = "reason"
 )
 
 // common flagsets to add to various functions
 var (
 	FsAddGuardian    = flag.NewFlagSet("", flag.ContinueOnError)
 	FsDeleteGuardian = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSetRoles       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsUpdateSuper    = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsAddGuardian.String(FlagAddress, "", "bech32 encoded account address")
 	FsAddGuardian.String(FlagDescription, "", "description of account")
+	FsAddGuardian.String(FlagRoles, "", "comma separated roles of account: oracle-operator, service-admin, token-admin, upgrade-operator, circuit-breaker or blocklist-admin")
+	FsAddGuardian.String(FlagExpiryTime, "", "time when the account expires, in RFC3339 format")
+	FsAddGuardian.Int64(FlagExpiryHeight, 0, "height at which the account expires")
 	FsDeleteGuardian.String(FlagAddress, "", "bech32 encoded account address")
+	FsSetRoles.String(FlagAddress, "", "bech32 encoded account address")
+	FsSetRoles.String(FlagRoles, "", "comma separated roles of account, empty to revoke all the roles")
+	FsUpdateSuper.String(FlagDescription, types.DoNotModify, "description of account")
+	FsUpdateSuper.String(FlagWebsite, types.DoNotModify, "website of the account operator")
+	FsUpdateSuper.String(FlagSecurityContact, types.DoNotModify, "security contact of the account operator")
 }
@@ -3,11 +3,13 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
@@ -24,6 +26,14 @@ func GetQueryCmd() *cobra.Command {
 	}
 	txCmd.AddCommand(
 		GetCmdQuerySupers(),
+		GetCmdQuerySuper(),
+		GetCmdQueryPendingActions(),
+		GetCmdQueryPendingAction(),
+		GetCmdQueryAuditLog(),
+		GetCmdQueryDisabledMsgTypes(),
+		GetCmdQueryFrozenAccounts(),
+		GetCmdQueryFrozenAccount(),
+		GetCmdQueryParams(),
 	)
 	return txCmd
 }
@@ -32,8 +42,9 @@ func GetQueryCmd() *cobra.Command {
 func GetCmdQuerySupers() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "supers",
-		Short:   "Query for all supers",
-		Example: fmt.Sprintf("%s query guardian supers", version.AppName),
+		Short:   "Query for all supers, optionally filtered by role, account type and adder",
+		Example: fmt.Sprintf("%s query guardian supers --role=oracle-operator --account-type=Ordinary --added-by=<address>", version.AppName),
+		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
@@ -41,9 +52,241 @@ func GetCmdQuerySupers() *cobra.Command {
 				return err
 			}
 
+			var role types.Role
+			if roleStr, _ := cmd.Flags().GetString(FlagRole); len(roleStr) > 0 {
+				if role, err = types.RoleFromString(roleStr); err != nil {
+					return err
+				}
+			}
+
+			accountType, _ := cmd.Flags().GetString(FlagAccountType)
+			addedBy, _ := cmd.Flags().GetString(FlagAddedBy)
+			if _, err := types.NewSuperFilter(role, accountType, addedBy); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Supers(context.Background(), &types.QuerySupersRequest{
+				Role:        role,
+				AccountType: accountType,
+				AddedBy:     addedBy,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	cmd.Flags().String(FlagRole, "", "role of the supers: oracle-operator, service-admin, token-admin, upgrade-operator, circuit-breaker or blocklist-admin")
+	cmd.Flags().String(FlagAccountType, "", "account type of the supers: Genesis or Ordinary")
+	cmd.Flags().String(FlagAddedBy, "", "bech32 encoded address which added the supers")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "supers")
+	return cmd
+}
+
+// GetCmdQuerySuper implements the query super command.
+func GetCmdQuerySuper() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "super [address]",
+		Short:   "Query a super by address",
+		Example: fmt.Sprintf("%s query guardian super <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Super(context.Background(), &types.QuerySuperRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Super)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPendingActions implements the query pending actions command.
+func GetCmdQueryPendingActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-actions",
+		Short:   "Query for all pending actions awaiting the approvals of the genesis supers",
+		Example: fmt.Sprintf("%s query guardian pending-actions", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingActions(context.Background(), &types.QueryPendingActionsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending actions")
+	return cmd
+}
+
+// GetCmdQueryPendingAction implements the query pending action command.
+func GetCmdQueryPendingAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-action [id]",
+		Short:   "Query a pending action by id",
+		Example: fmt.Sprintf("%s query guardian pending-action 1", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid action id: %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingAction(context.Background(), &types.QueryPendingActionRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.PendingAction)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAuditLog implements the query audit log command.
+func GetCmdQueryAuditLog() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "audit-log",
+		Short:   "Query the audit log of the supers added, deleted and expired, optionally of a target super",
+		Example: fmt.Sprintf("%s query guardian audit-log --target=<address>", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			target, _ := cmd.Flags().GetString(FlagTarget)
+			if len(target) > 0 {
+				if _, err := sdk.AccAddressFromBech32(target); err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AuditLog(context.Background(), &types.QueryAuditLogRequest{
+				Target:     target,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	cmd.Flags().String(FlagTarget, "", "bech32 encoded address of the super changed")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "audit log")
+	return cmd
+}
+
+// GetCmdQueryDisabledMsgTypes implements the query disabled msg types command.
+func GetCmdQueryDisabledMsgTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "disabled-msg-types",
+		Short:   "Query the type urls of the msgs disabled by the circuit breakers",
+		Example: fmt.Sprintf("%s query guardian disabled-msg-types", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DisabledMsgTypes(context.Background(), &types.QueryDisabledMsgTypesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFrozenAccounts implements the query frozen accounts command.
+func GetCmdQueryFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-accounts",
+		Short:   "Query for all accounts frozen by the blocklist admins",
+		Example: fmt.Sprintf("%s query guardian frozen-accounts", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Supers(context.Background(), &types.QuerySupersRequest{})
+			res, err := queryClient.FrozenAccounts(context.Background(), &types.QueryFrozenAccountsRequest{Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -52,5 +295,66 @@ func GetCmdQuerySupers() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen accounts")
+	return cmd
+}
+
+// GetCmdQueryFrozenAccount implements the query frozen account command.
+func GetCmdQueryFrozenAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-account [address]",
+		Short:   "Query a frozen account by address",
+		Example: fmt.Sprintf("%s query guardian frozen-account <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenAccount(context.Background(), &types.QueryFrozenAccountRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.FrozenAccount)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current guardian parameters",
+		Example: fmt.Sprintf("%s query guardian params", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
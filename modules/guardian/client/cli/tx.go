@@ -2,12 +2,16 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
@@ -25,6 +29,13 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetCmdCreateSuper(),
 		GetCmdDeleteSuper(),
+		GetCmdSetRoles(),
+		GetCmdApproveAction(),
+		GetCmdUpdateSuper(),
+		GetCmdDisableMsgTypes(),
+		GetCmdEnableMsgTypes(),
+		GetCmdFreezeAccount(),
+		GetCmdUnfreezeAccount(),
 	)
 	return txCmd
 }
@@ -35,7 +46,7 @@ func GetCmdCreateSuper() *cobra.Command {
 		Use:   "add-super",
 		Short: "Add a new super",
 		Example: fmt.Sprintf(
-			"%s tx guardian add-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<added address> --description=<name>",
+			"%s tx guardian add-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<added address> --description=<name> --roles=oracle-operator,token-admin --expiry-time=2021-12-31T00:00:00Z",
 			version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -56,7 +67,22 @@ func GetCmdCreateSuper() *cobra.Command {
 				return err
 			}
 			description, _ := cmd.Flags().GetString(FlagDescription)
-			msg := types.NewMsgAddSuper(description, pAddr, fromAddr)
+			rolesStr, _ := cmd.Flags().GetString(FlagRoles)
+			roles, err := types.RolesFromString(rolesStr)
+			if err != nil {
+				return err
+			}
+			var expiryTime *time.Time
+			if expiryTimeStr, _ := cmd.Flags().GetString(FlagExpiryTime); len(expiryTimeStr) > 0 {
+				t, err := time.Parse(time.RFC3339, expiryTimeStr)
+				if err != nil {
+					return fmt.Errorf("invalid --%s: %s", FlagExpiryTime, err)
+				}
+				expiryTime = &t
+			}
+			expiryHeight, _ := cmd.Flags().GetInt64(FlagExpiryHeight)
+
+			msg := types.NewMsgAddSuper(description, pAddr, fromAddr, roles...).WithExpiry(expiryTime, expiryHeight)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -106,3 +132,369 @@ func GetCmdDeleteSuper() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdSetRoles implements the set roles command.
+func GetCmdSetRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-roles",
+		Short: "Set the roles of a super",
+		Example: fmt.Sprintf(
+			"%s tx guardian set-roles --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<super address> --roles=oracle-operator,service-admin",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			rolesStr, _ := cmd.Flags().GetString(FlagRoles)
+			roles, err := types.RolesFromString(rolesStr)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetRoles(pAddr, fromAddr, roles...)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSetRoles)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdApproveAction implements the approve action command.
+func GetCmdApproveAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-action [id]",
+		Short: "Approve a pending action to add or delete a super",
+		Example: fmt.Sprintf(
+			"%s tx guardian approve-action 1 --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid action id: %s", args[0])
+			}
+			msg := types.NewMsgApproveAction(id, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUpdateSuper implements the update super command.
+func GetCmdUpdateSuper() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-super",
+		Short: "Update the description and contact metadata of the super signing the tx",
+		Example: fmt.Sprintf(
+			"%s tx guardian update-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --description=<name> --website=<website> --security-contact=<email>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			description, _ := cmd.Flags().GetString(FlagDescription)
+			website, _ := cmd.Flags().GetString(FlagWebsite)
+			securityContact, _ := cmd.Flags().GetString(FlagSecurityContact)
+
+			msg := types.NewMsgUpdateSuper(clientCtx.GetFromAddress(), description, website, securityContact)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsUpdateSuper)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSubmitAddSuperProposal implements the command to submit an add super proposal
+func GetCmdSubmitAddSuperProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-super [address] [super-description]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to add a super of any account type",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal add-super <address> <super-description> --account-type=Genesis --roles=oracle-operator --title=<title> --description=<description> --deposit=1000iris --from=<key-name> --chain-id=<chain-id> --fees=0.3iris",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			accountTypeStr, _ := cmd.Flags().GetString(FlagAccountType)
+			accountType, err := types.AccountTypeFromString(accountTypeStr)
+			if err != nil {
+				return err
+			}
+			rolesStr, _ := cmd.Flags().GetString(FlagRoles)
+			roles, err := types.RolesFromString(rolesStr)
+			if err != nil {
+				return err
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			description, _ := cmd.Flags().GetString(govcli.FlagDescription)
+			content := types.NewAddSuperProposal(title, description, address, accountType, args[1], roles...)
+
+			return submitProposal(cmd, content)
+		},
+	}
+	addProposalFlags(cmd)
+	cmd.Flags().String(FlagAccountType, types.Ordinary.String(), "account type of the super: Genesis or Ordinary")
+	cmd.Flags().String(FlagRoles, "", "comma separated roles of the super: oracle-operator, service-admin, token-admin, upgrade-operator, circuit-breaker or blocklist-admin")
+	return cmd
+}
+
+// GetCmdSubmitDeleteSuperProposal implements the command to submit a delete super proposal
+func GetCmdSubmitDeleteSuperProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-super [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to delete a super of any account type",
+		Long:  "Submit a proposal to delete a super of any account type. The last genesis super can't be deleted.",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal delete-super <address> --title=<title> --description=<description> --deposit=1000iris --from=<key-name> --chain-id=<chain-id> --fees=0.3iris",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			description, _ := cmd.Flags().GetString(govcli.FlagDescription)
+			content := types.NewDeleteSuperProposal(title, description, address)
+
+			return submitProposal(cmd, content)
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitChangeSuperTypeProposal implements the command to submit a change super type proposal
+func GetCmdSubmitChangeSuperTypeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-super-type [address] [account-type]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to change the account type of a super",
+		Long:  "Submit a proposal to change the account type of a super to Genesis or Ordinary. The last genesis super can't be changed to Ordinary.",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal change-super-type <address> Genesis --title=<title> --description=<description> --deposit=1000iris --from=<key-name> --chain-id=<chain-id> --fees=0.3iris",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			accountType, err := types.AccountTypeFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			description, _ := cmd.Flags().GetString(govcli.FlagDescription)
+			content := types.NewChangeSuperTypeProposal(title, description, address, accountType)
+
+			return submitProposal(cmd, content)
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// addProposalFlags adds the common flags of the proposal commands
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+// submitProposal generates or broadcasts the tx submitting the proposal content
+func submitProposal(cmd *cobra.Command, content govtypes.Content) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	depositStr, _ := cmd.Flags().GetString(govcli.FlagDeposit)
+	deposit, err := sdk.ParseCoins(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// GetCmdDisableMsgTypes implements the disable msg types command.
+func GetCmdDisableMsgTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable-msg-types [msg-type-url]...",
+		Short: "Disable the msg types, the txs containing them are rejected until re-enabled",
+		Example: fmt.Sprintf(
+			"%s tx guardian disable-msg-types /irismod.token.MsgMintToken --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDisableMsgTypes(args, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdEnableMsgTypes implements the enable msg types command.
+func GetCmdEnableMsgTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-msg-types [msg-type-url]...",
+		Short: "Re-enable the disabled msg types",
+		Example: fmt.Sprintf(
+			"%s tx guardian enable-msg-types /irismod.token.MsgMintToken --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEnableMsgTypes(args, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdFreezeAccount implements the freeze account command.
+func GetCmdFreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-account [address]",
+		Short: "Freeze an account, the txs signed by which are rejected until unfrozen",
+		Example: fmt.Sprintf(
+			"%s tx guardian freeze-account <address> --reason=<reason> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			reason, _ := cmd.Flags().GetString(FlagReason)
+
+			msg := types.NewMsgFreezeAccount(address, reason, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagReason, "", "reason to freeze the account")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUnfreezeAccount implements the unfreeze account command.
+func GetCmdUnfreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-account [address]",
+		Short: "Unfreeze a frozen account",
+		Example: fmt.Sprintf(
+			"%s tx guardian unfreeze-account <address> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreezeAccount(address, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/irisnet/irishub/modules/guardian/client/cli"
+	"github.com/irisnet/irishub/modules/guardian/client/rest"
+)
+
+// proposal handlers of the guardian membership proposals
+var (
+	AddSuperProposalHandler        = govclient.NewProposalHandler(cli.GetCmdSubmitAddSuperProposal, rest.AddSuperProposalRESTHandler)
+	DeleteSuperProposalHandler     = govclient.NewProposalHandler(cli.GetCmdSubmitDeleteSuperProposal, rest.DeleteSuperProposalRESTHandler)
+	ChangeSuperTypeProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitChangeSuperTypeProposal, rest.ChangeSuperTypeProposalRESTHandler)
+)
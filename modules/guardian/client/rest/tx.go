@@ -0,0 +1,159 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// AddSuperProposalReq defines the properties of an add super proposal request's body.
+type AddSuperProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title            string         `json:"title" yaml:"title"`
+	Description      string         `json:"description" yaml:"description"`
+	Address          sdk.AccAddress `json:"address" yaml:"address"`
+	AccountType      string         `json:"account_type" yaml:"account_type"`
+	SuperDescription string         `json:"super_description" yaml:"super_description"`
+	Roles            string         `json:"roles" yaml:"roles"`
+	Proposer         sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit          sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// DeleteSuperProposalReq defines the properties of a delete super proposal request's body.
+type DeleteSuperProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Address     sdk.AccAddress `json:"address" yaml:"address"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ChangeSuperTypeProposalReq defines the properties of a change super type proposal request's body.
+type ChangeSuperTypeProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Address     sdk.AccAddress `json:"address" yaml:"address"`
+	AccountType string         `json:"account_type" yaml:"account_type"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// AddSuperProposalRESTHandler returns a ProposalRESTHandler that exposes the add super REST handler with a given sub-route.
+func AddSuperProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_super",
+		Handler:  postAddSuperProposalHandlerFn(cliCtx),
+	}
+}
+
+// DeleteSuperProposalRESTHandler returns a ProposalRESTHandler that exposes the delete super REST handler with a given sub-route.
+func DeleteSuperProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "delete_super",
+		Handler:  postDeleteSuperProposalHandlerFn(cliCtx),
+	}
+}
+
+// ChangeSuperTypeProposalRESTHandler returns a ProposalRESTHandler that exposes the change super type REST handler with a given sub-route.
+func ChangeSuperTypeProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "change_super_type",
+		Handler:  postChangeSuperTypeProposalHandlerFn(cliCtx),
+	}
+}
+
+func postAddSuperProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddSuperProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		accountType, err := types.AccountTypeFromString(req.AccountType)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		roles, err := types.RolesFromString(req.Roles)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewAddSuperProposal(req.Title, req.Description, req.Address, accountType, req.SuperDescription, roles...)
+		writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+	}
+}
+
+func postDeleteSuperProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DeleteSuperProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewDeleteSuperProposal(req.Title, req.Description, req.Address)
+		writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+	}
+}
+
+func postChangeSuperTypeProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ChangeSuperTypeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		accountType, err := types.AccountTypeFromString(req.AccountType)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewChangeSuperTypeProposal(req.Title, req.Description, req.Address, accountType)
+		writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+	}
+}
+
+func writeProposalTx(
+	w http.ResponseWriter,
+	cliCtx client.Context,
+	baseReq rest.BaseReq,
+	content govtypes.Content,
+	deposit sdk.Coins,
+	proposer sdk.AccAddress,
+) {
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+}
@@ -3,6 +3,8 @@ package testutil
 import (
 	"fmt"
 
+	"github.com/spf13/cobra"
+
 	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -32,6 +34,74 @@ func DeleteSuperExec(clientCtx client.Context, from string, extraArgs ...string)
 	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdDeleteSuper(), args)
 }
 
+func SetRolesExec(clientCtx client.Context, from string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdSetRoles(), args)
+}
+
+func ApproveActionExec(clientCtx client.Context, from string, id string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		id,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdApproveAction(), args)
+}
+
+func UpdateSuperExec(clientCtx client.Context, from string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdUpdateSuper(), args)
+}
+
+func DisableMsgTypesExec(clientCtx client.Context, from string, msgTypeURL string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		msgTypeURL,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdDisableMsgTypes(), args)
+}
+
+func EnableMsgTypesExec(clientCtx client.Context, from string, msgTypeURL string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		msgTypeURL,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdEnableMsgTypes(), args)
+}
+
+func FreezeAccountExec(clientCtx client.Context, from string, address string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		address,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdFreezeAccount(), args)
+}
+
+func UnfreezeAccountExec(clientCtx client.Context, from string, address string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		address,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdUnfreezeAccount(), args)
+}
+
 func QuerySupersExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{
 		fmt.Sprintf("--%s=json", cli.OutputFlag),
@@ -40,3 +110,92 @@ func QuerySupersExec(clientCtx client.Context, extraArgs ...string) (testutil.Bu
 
 	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdQuerySupers(), args)
 }
+
+func QuerySuperExec(clientCtx client.Context, address string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		address,
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdQuerySuper(), args)
+}
+
+func QueryPendingActionsExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdQueryPendingActions(), args)
+}
+
+func QueryAuditLogExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdQueryAuditLog(), args)
+}
+
+func QueryDisabledMsgTypesExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdQueryDisabledMsgTypes(), args)
+}
+
+func QueryFrozenAccountsExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdQueryFrozenAccounts(), args)
+}
+
+func QueryFrozenAccountExec(clientCtx client.Context, address string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		address,
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdQueryFrozenAccount(), args)
+}
+
+func QueryParamsExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdQueryParams(), args)
+}
+
+func SubmitAddSuperProposalExec(clientCtx client.Context, from string, extraArgs ...string) (testutil.BufferWriter, error) {
+	return submitProposalExec(clientCtx, guardiancli.GetCmdSubmitAddSuperProposal(), from, extraArgs...)
+}
+
+func SubmitDeleteSuperProposalExec(clientCtx client.Context, from string, extraArgs ...string) (testutil.BufferWriter, error) {
+	return submitProposalExec(clientCtx, guardiancli.GetCmdSubmitDeleteSuperProposal(), from, extraArgs...)
+}
+
+func SubmitChangeSuperTypeProposalExec(clientCtx client.Context, from string, extraArgs ...string) (testutil.BufferWriter, error) {
+	return submitProposalExec(clientCtx, guardiancli.GetCmdSubmitChangeSuperTypeProposal(), from, extraArgs...)
+}
+
+func submitProposalExec(clientCtx client.Context, cmd *cobra.Command, from string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+	}
+	args = append(args, extraArgs...)
+
+	// tx flags are added by the gov submit-proposal command
+	flags.AddTxFlagsToCmd(cmd)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+}
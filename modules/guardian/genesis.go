@@ -1,6 +1,8 @@
 package guardian
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/irisnet/irishub/modules/guardian/keeper"
@@ -9,10 +11,35 @@ import (
 
 // InitGenesis stores genesis data
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize guardian genesis state: %s", err.Error()))
+	}
+
+	keeper.SetParams(ctx, data.Params)
+
 	// Add supers
 	for _, super := range data.Supers {
 		keeper.AddSuper(ctx, super)
 	}
+
+	for _, action := range data.PendingActions {
+		keeper.SetPendingAction(ctx, action)
+	}
+	if data.NextActionId > 0 {
+		keeper.SetNextActionID(ctx, data.NextActionId)
+	}
+
+	for _, record := range data.AuditLog {
+		keeper.SetAuditRecord(ctx, record)
+	}
+
+	for _, msgTypeURL := range data.DisabledMsgTypes {
+		keeper.DisableMsgType(ctx, msgTypeURL)
+	}
+
+	for _, account := range data.FrozenAccounts {
+		keeper.SetFrozenAccount(ctx, account)
+	}
 }
 
 // ExportGenesis outputs genesis data
@@ -26,5 +53,43 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(supers)
+	var pendingActions []types.PendingAction
+	k.IteratePendingActions(
+		ctx,
+		func(action types.PendingAction) bool {
+			pendingActions = append(pendingActions, action)
+			return false
+		},
+	)
+
+	var auditLog []types.AuditRecord
+	k.IterateAuditLog(
+		ctx,
+		func(record types.AuditRecord) bool {
+			auditLog = append(auditLog, record)
+			return false
+		},
+	)
+
+	var disabledMsgTypes []string
+	k.IterateDisabledMsgTypes(
+		ctx,
+		func(msgTypeURL string) bool {
+			disabledMsgTypes = append(disabledMsgTypes, msgTypeURL)
+			return false
+		},
+	)
+
+	var frozenAccounts []types.FrozenAccount
+	k.IterateFrozenAccounts(
+		ctx,
+		func(account types.FrozenAccount) bool {
+			frozenAccounts = append(frozenAccounts, account)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(
+		supers, k.GetParams(ctx), pendingActions, k.GetNextActionID(ctx), auditLog, disabledMsgTypes, frozenAccounts,
+	)
 }
@@ -2,6 +2,7 @@ package guardian_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -41,3 +42,170 @@ func (suite *TestSuite) TestExportGenesis() {
 	defaultGenesis := types.DefaultGenesisState()
 	suite.Equal(exportedGenesis, defaultGenesis)
 }
+
+func (suite *TestSuite) TestInitExportGenesisRoles() {
+	genesisAddr := sdk.AccAddress("genesis_super_______")
+	ordinaryAddr := sdk.AccAddress("ordinary_super______")
+	data := types.NewGenesisState([]types.Super{
+		types.NewSuper("genesis", types.Genesis, genesisAddr, genesisAddr),
+		types.NewSuper("ordinary", types.Ordinary, ordinaryAddr, genesisAddr, types.RoleOracleOperator, types.RoleTokenAdmin),
+	}, types.DefaultParams(), nil, 1, nil, nil, nil)
+	suite.NoError(types.ValidateGenesis(*data))
+
+	guardian.InitGenesis(suite.ctx, suite.keeper, *data)
+	suite.True(suite.keeper.AuthorizedFor(suite.ctx, ordinaryAddr, types.RoleTokenAdmin))
+	suite.False(suite.keeper.AuthorizedFor(suite.ctx, ordinaryAddr, types.RoleServiceAdmin))
+
+	exported := guardian.ExportGenesis(suite.ctx, suite.keeper)
+	suite.ElementsMatch(data.Supers, exported.Supers)
+}
+
+func (suite *TestSuite) TestValidateGenesisRoles() {
+	addr := sdk.AccAddress("ordinary_super______")
+	data := types.NewGenesisState([]types.Super{
+		types.NewSuper("ordinary", types.Ordinary, addr, addr, types.RoleOracleOperator, types.RoleOracleOperator),
+	}, types.DefaultParams(), nil, 1, nil, nil, nil)
+	suite.Error(types.ValidateGenesis(*data))
+
+	data.Supers[0].Roles = []types.Role{types.RoleUnspecified}
+	suite.Error(types.ValidateGenesis(*data))
+}
+
+func (suite *TestSuite) TestInitExportGenesisPendingActions() {
+	genesisAddr := sdk.AccAddress("genesis_super_______")
+	ordinaryAddr := sdk.AccAddress("ordinary_super______")
+	deadline := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	action := types.NewAddSuperAction(types.NewSuper("ordinary", types.Ordinary, ordinaryAddr, genesisAddr), deadline)
+	action.Id = 3
+	data := types.NewGenesisState(
+		[]types.Super{
+			types.NewSuper("genesis", types.Genesis, genesisAddr, genesisAddr),
+			types.NewSuper("genesis", types.Genesis, sdk.AccAddress("genesis_super_2_____"), genesisAddr),
+		},
+		types.NewParams(2, time.Hour, nil, sdk.OneDec(), 10, types.RoleOracleOperator),
+		[]types.PendingAction{action},
+		4,
+		nil,
+		nil,
+		nil,
+	)
+	suite.NoError(types.ValidateGenesis(*data))
+
+	guardian.InitGenesis(suite.ctx, suite.keeper, *data)
+	suite.Equal(uint32(2), suite.keeper.GetParams(suite.ctx).GenesisThreshold)
+	suite.Equal(uint64(4), suite.keeper.GetNextActionID(suite.ctx))
+
+	exported := guardian.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Equal(data.Params, exported.Params)
+	suite.Equal(data.PendingActions, exported.PendingActions)
+	suite.Equal(data.NextActionId, exported.NextActionId)
+}
+
+func (suite *TestSuite) TestValidateGenesisThreshold() {
+	genesisAddr := sdk.AccAddress("genesis_super_______")
+	ordinaryAddr := sdk.AccAddress("ordinary_super______")
+	data := types.NewGenesisState([]types.Super{
+		types.NewSuper("genesis", types.Genesis, genesisAddr, genesisAddr),
+		types.NewSuper("ordinary", types.Ordinary, ordinaryAddr, genesisAddr),
+	}, types.NewParams(1, time.Hour, nil, sdk.OneDec(), 10, types.RoleOracleOperator), nil, 1, nil, nil, nil)
+	suite.NoError(types.ValidateGenesis(*data))
+
+	// the ordinary supers don't count towards the threshold
+	data.Params.GenesisThreshold = 2
+	suite.Error(types.ValidateGenesis(*data))
+
+	// the threshold is unchecked without any super
+	data.Supers = nil
+	suite.NoError(types.ValidateGenesis(*data))
+}
+
+func (suite *TestSuite) TestValidateGenesisPendingActions() {
+	addr := sdk.AccAddress("genesis_super_______")
+	action := types.NewDeleteSuperAction(addr, addr, time.Now())
+	action.Id = 1
+
+	data := types.NewGenesisState(nil, types.DefaultParams(), []types.PendingAction{action}, 2, nil, nil, nil)
+	suite.NoError(types.ValidateGenesis(*data))
+
+	// the id of a pending action must be below the next action id
+	data.NextActionId = 1
+	suite.Error(types.ValidateGenesis(*data))
+
+	data.NextActionId = 2
+	data.Params.GenesisThreshold = 0
+	suite.Error(types.ValidateGenesis(*data))
+}
+
+func (suite *TestSuite) TestInitExportGenesisAuditLog() {
+	genesisAddr := sdk.AccAddress("genesis_super_______")
+	ordinaryAddr := sdk.AccAddress("ordinary_super______")
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	auditLog := []types.AuditRecord{
+		types.NewAuditRecord(1, types.OperationAddSuper, genesisAddr.String(), ordinaryAddr.String(), 10, now),
+		types.NewAuditRecord(2, types.OperationExpireSuper, "", ordinaryAddr.String(), 20, now.Add(time.Hour)),
+	}
+	data := types.NewGenesisState(
+		[]types.Super{types.NewSuper("genesis", types.Genesis, genesisAddr, genesisAddr)},
+		types.DefaultParams(), nil, 1, auditLog, nil, nil,
+	)
+	suite.NoError(types.ValidateGenesis(*data))
+
+	guardian.InitGenesis(suite.ctx, suite.keeper, *data)
+	suite.Equal(auditLog, guardian.ExportGenesis(suite.ctx, suite.keeper).AuditLog)
+
+	// the log is appended after the imported records
+	record := suite.keeper.AppendAuditRecord(suite.ctx, types.OperationDeleteSuper, genesisAddr.String(), ordinaryAddr.String())
+	suite.Equal(uint64(3), record.Id)
+
+	// the records must be in order
+	data.AuditLog = []types.AuditRecord{auditLog[1], auditLog[0]}
+	suite.Error(types.ValidateGenesis(*data))
+
+	data.AuditLog = []types.AuditRecord{types.NewAuditRecord(1, types.OperationUnspecified, "", ordinaryAddr.String(), 10, now)}
+	suite.Error(types.ValidateGenesis(*data))
+}
+
+func (suite *TestSuite) TestInitExportGenesisDisabledMsgTypes() {
+	genesisAddr := sdk.AccAddress("genesis_super_______")
+	disabled := []string{"/cosmos.bank.v1beta1.MsgSend", "/irismod.token.MsgMintToken"}
+	data := types.NewGenesisState(
+		[]types.Super{types.NewSuper("genesis", types.Genesis, genesisAddr, genesisAddr)},
+		types.DefaultParams(), nil, 1, nil, disabled, nil,
+	)
+	suite.NoError(types.ValidateGenesis(*data))
+
+	guardian.InitGenesis(suite.ctx, suite.keeper, *data)
+	suite.True(suite.keeper.IsMsgTypeDisabled(suite.ctx, disabled[1]))
+	suite.Equal(disabled, guardian.ExportGenesis(suite.ctx, suite.keeper).DisabledMsgTypes)
+
+	// the circuit breaker msgs can't be disabled
+	data.DisabledMsgTypes = []string{types.MsgTypeURL(&types.MsgEnableMsgTypes{})}
+	suite.Error(types.ValidateGenesis(*data))
+
+	data.DisabledMsgTypes = []string{disabled[0], disabled[0]}
+	suite.Error(types.ValidateGenesis(*data))
+}
+
+func (suite *TestSuite) TestInitExportGenesisFrozenAccounts() {
+	genesisAddr := sdk.AccAddress("genesis_super_______")
+	frozenAddr := sdk.AccAddress("frozen______________")
+	frozen := []types.FrozenAccount{types.NewFrozenAccount(frozenAddr, genesisAddr, "stolen key", 10)}
+	data := types.NewGenesisState(
+		[]types.Super{types.NewSuper("genesis", types.Genesis, genesisAddr, genesisAddr)},
+		types.DefaultParams(), nil, 1, nil, nil, frozen,
+	)
+	suite.NoError(types.ValidateGenesis(*data))
+
+	guardian.InitGenesis(suite.ctx, suite.keeper, *data)
+	suite.True(suite.keeper.IsAccountFrozen(suite.ctx, frozenAddr))
+	suite.Equal(frozen, guardian.ExportGenesis(suite.ctx, suite.keeper).FrozenAccounts)
+
+	// the supers can't be frozen
+	data.FrozenAccounts = []types.FrozenAccount{types.NewFrozenAccount(genesisAddr, genesisAddr, "", 10)}
+	suite.Error(types.ValidateGenesis(*data))
+
+	data.FrozenAccounts = []types.FrozenAccount{frozen[0], frozen[0]}
+	suite.Error(types.ValidateGenesis(*data))
+}
@@ -3,6 +3,7 @@ package guardian
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/irisnet/irishub/modules/guardian/keeper"
 	"github.com/irisnet/irishub/modules/guardian/types"
@@ -24,8 +25,75 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.DeleteSuper(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSetRoles:
+			res, err := msgServer.SetRoles(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgApproveAction:
+			res, err := msgServer.ApproveAction(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUpdateSuper:
+			res, err := msgServer.UpdateSuper(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDisableMsgTypes:
+			res, err := msgServer.DisableMsgTypes(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgEnableMsgTypes:
+			res, err := msgServer.EnableMsgTypes(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFreezeAccount:
+			res, err := msgServer.FreezeAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUnfreezeAccount:
+			res, err := msgServer.UnfreezeAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized bank message type: %T", msg)
 		}
 	}
 }
+
+// NewParamChangeProposalHandler wraps the handler of the param change proposals, so that the
+// proposals raising the genesis threshold above the number of the genesis supers fail instead
+// of leaving the pending actions unapprovable
+func NewParamChangeProposalHandler(k keeper.Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := paramsHandler(cacheCtx, content); err != nil {
+			return err
+		}
+
+		if err := k.ValidateGenesisThreshold(cacheCtx, k.GetParams(cacheCtx).GenesisThreshold); err != nil {
+			return err
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return nil
+	}
+}
+
+// NewProposalHandler returns a handler for "guardian" type governance proposals.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddSuperProposal:
+			return keeper.HandleAddSuperProposal(ctx, k, c)
+
+		case *types.DeleteSuperProposal:
+			return keeper.HandleDeleteSuperProposal(ctx, k, c)
+
+		case *types.ChangeSuperTypeProposal:
+			return keeper.HandleChangeSuperTypeProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized guardian proposal content type: %T", c)
+		}
+	}
+}
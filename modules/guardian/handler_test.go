@@ -0,0 +1,39 @@
+package guardian_test
+
+import (
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/irisnet/irishub/modules/guardian"
+	"github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+func (suite *TestSuite) TestParamChangeProposalHandler() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	handler := guardian.NewParamChangeProposalHandler(app.GuardianKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+
+	newProposal := func(threshold string) *paramproposal.ParameterChangeProposal {
+		return paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(types.ModuleName, string(types.KeyGenesisThreshold), threshold),
+		})
+	}
+
+	// the threshold is unchecked without any super
+	suite.NoError(handler(ctx, newProposal(`3`)))
+	suite.Equal(uint32(3), app.GuardianKeeper.GetParams(ctx).GenesisThreshold)
+
+	genesisAddr := sdk.AccAddress("genesis_super_______")
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, genesisAddr, genesisAddr))
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, sdk.AccAddress("genesis_super_2_____"), genesisAddr))
+	suite.NoError(handler(ctx, newProposal(`2`)))
+	suite.Equal(uint32(2), app.GuardianKeeper.GetParams(ctx).GenesisThreshold)
+
+	// the proposals raising the threshold above the genesis supers fail and leave the params untouched
+	suite.Error(handler(ctx, newProposal(`3`)))
+	suite.Equal(uint32(2), app.GuardianKeeper.GetParams(ctx).GenesisThreshold)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// CircuitBreakerDecorator rejects the txs containing msgs of the types disabled by the circuit breakers
+type CircuitBreakerDecorator struct {
+	k Keeper
+}
+
+// NewCircuitBreakerDecorator returns a CircuitBreakerDecorator
+func NewCircuitBreakerDecorator(k Keeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		k: k,
+	}
+}
+
+// AnteHandle returns an AnteHandler that checks if any msg of the tx is of a disabled type
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		if msgTypeURL := types.MsgTypeURL(msg); cbd.k.IsMsgTypeDisabled(ctx, msgTypeURL) {
+			return ctx, sdkerrors.Wrap(types.ErrMsgTypeDisabled, msgTypeURL)
+		}
+	}
+	// continue
+	return next(ctx, tx, simulate)
+}
+
+// BlocklistDecorator rejects the txs signed by the accounts frozen by the blocklist admins
+type BlocklistDecorator struct {
+	k Keeper
+}
+
+// NewBlocklistDecorator returns a BlocklistDecorator
+func NewBlocklistDecorator(k Keeper) BlocklistDecorator {
+	return BlocklistDecorator{
+		k: k,
+	}
+}
+
+// AnteHandle returns an AnteHandler that checks if any signer or the fee payer of the tx is frozen
+func (bd BlocklistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var signers []sdk.AccAddress
+	for _, msg := range tx.GetMsgs() {
+		signers = append(signers, msg.GetSigners()...)
+	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		signers = append(signers, feeTx.FeePayer())
+	}
+
+	for _, signer := range signers {
+		if bd.k.IsAccountFrozen(ctx, signer) {
+			return ctx, sdkerrors.Wrap(types.ErrAccountFrozen, signer.String())
+		}
+	}
+	// continue
+	return next(ctx, tx, simulate)
+}
+
+// FeeExemptionDecorator discounts the min gas prices of the fee exempt txs sent by the supers granted the fee exempt role,
+// it must be placed before the fee decorators
+type FeeExemptionDecorator struct {
+	k Keeper
+}
+
+// NewFeeExemptionDecorator returns a FeeExemptionDecorator
+func NewFeeExemptionDecorator(k Keeper) FeeExemptionDecorator {
+	return FeeExemptionDecorator{
+		k: k,
+	}
+}
+
+// AnteHandle returns an AnteHandler that checks if the tx is fee exempt and counts it against the rate limit
+// of its signers. The txs beyond the rate limit are charged in full.
+func (fed FeeExemptionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params := fed.k.GetParams(ctx)
+
+	signers, exempt := fed.k.FeeExemptSigners(ctx, tx, params)
+	if !exempt {
+		// continue
+		return next(ctx, tx, simulate)
+	}
+
+	for _, signer := range signers {
+		fed.k.IncreaseFeeExemptTxCount(ctx, signer)
+	}
+
+	minGasPrices := ctx.MinGasPrices().MulDecTruncate(sdk.OneDec().Sub(params.FeeDiscount))
+	ctx = ctx.WithMinGasPrices(minGasPrices).WithValue(feeDiscountKey{}, params.FeeDiscount)
+	return next(ctx, tx, simulate)
+}
+
+type feeDiscountKey struct{}
+
+// FeeDiscount returns the discount granted to the tx by the FeeExemptionDecorator, for the fee
+// decorators placed after it to apply to the fees they enforce
+func FeeDiscount(ctx sdk.Context) sdk.Dec {
+	if discount, ok := ctx.Value(feeDiscountKey{}).(sdk.Dec); ok {
+		return discount
+	}
+	return sdk.ZeroDec()
+}
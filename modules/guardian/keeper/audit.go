@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// AppendAuditRecord appends the record of the membership change made at the current block to the audit log
+func (k Keeper) AppendAuditRecord(ctx sdk.Context, operation types.AuditOperation, actor, target string) types.AuditRecord {
+	record := types.NewAuditRecord(k.getNextAuditRecordID(ctx), operation, actor, target, ctx.BlockHeight(), ctx.BlockTime())
+	k.SetAuditRecord(ctx, record)
+	return record
+}
+
+// SetAuditRecord stores the audit record
+func (k Keeper) SetAuditRecord(ctx sdk.Context, record types.AuditRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAuditRecordKey(record.Id), k.cdc.MustMarshalBinaryBare(&record))
+}
+
+// IterateAuditLog iterates through the audit records in the order they are appended
+func (k Keeper) IterateAuditLog(
+	ctx sdk.Context,
+	op func(record types.AuditRecord) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.AuditLogKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.AuditRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+		if stop := op(record); stop {
+			break
+		}
+	}
+}
+
+// getNextAuditRecordID returns the id following the last audit record
+func (k Keeper) getNextAuditRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.AuditLogKey)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 1
+	}
+	return sdk.BigEndianToUint64(iterator.Key()[len(types.AuditLogKey):]) + 1
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func (suite *KeeperTestSuite) getAuditLog(ctx sdk.Context) (records []types.AuditRecord) {
+	suite.keeper.IterateAuditLog(ctx, func(record types.AuditRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+func (suite *KeeperTestSuite) TestAuditLog() {
+	now := time.Unix(1600000000, 0).UTC()
+	ctx := suite.ctx.WithBlockHeader(tmproto.Header{Height: 10, Time: now})
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	// the supers set directly, e.g. at genesis, are not audited
+	suite.keeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.Empty(suite.getAuditLog(ctx))
+
+	_, err := msgServer.AddSuper(sdk.WrapSDKContext(ctx), types.NewMsgAddSuper("ordinary", addrs[1], addrs[0]))
+	suite.NoError(err)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 11, Time: now.Add(time.Minute)})
+	_, err = msgServer.DeleteSuper(sdk.WrapSDKContext(ctx), types.NewMsgDeleteSuper(addrs[1], addrs[0]))
+	suite.NoError(err)
+
+	// the failed operations are not audited
+	_, err = msgServer.DeleteSuper(sdk.WrapSDKContext(ctx), types.NewMsgDeleteSuper(addrs[1], addrs[0]))
+	suite.Error(err)
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	suite.NoError(keeper.HandleAddSuperProposal(ctx, suite.keeper, types.NewAddSuperProposal("title", "description", addrs[2], types.Ordinary, "ordinary")))
+
+	suite.keeper.AddSuper(ctx, types.NewSuper("expiring", types.Ordinary, addrs[1], addrs[0]).WithExpiry(nil, 12))
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 12, Time: now.Add(2 * time.Minute)})
+	suite.Len(suite.keeper.PruneExpiredSupers(ctx), 1)
+
+	suite.Equal([]types.AuditRecord{
+		types.NewAuditRecord(1, types.OperationAddSuper, addrs[0].String(), addrs[1].String(), 10, now),
+		types.NewAuditRecord(2, types.OperationDeleteSuper, addrs[0].String(), addrs[1].String(), 11, now.Add(time.Minute)),
+		types.NewAuditRecord(3, types.OperationAddSuper, govAddr.String(), addrs[2].String(), 11, now.Add(time.Minute)),
+		types.NewAuditRecord(4, types.OperationExpireSuper, "", addrs[1].String(), 12, now.Add(2*time.Minute)),
+	}, suite.getAuditLog(ctx))
+}
+
+func (suite *KeeperTestSuite) TestAppendAuditRecordAfterImport() {
+	imported := types.NewAuditRecord(7, types.OperationAddSuper, addrs[0].String(), addrs[1].String(), 5, time.Unix(1500000000, 0).UTC())
+	suite.keeper.SetAuditRecord(suite.ctx, imported)
+
+	record := suite.keeper.AppendAuditRecord(suite.ctx, types.OperationDeleteSuper, addrs[0].String(), addrs[1].String())
+	suite.Equal(uint64(8), record.Id)
+	suite.Equal([]types.AuditRecord{imported, record}, suite.getAuditLog(suite.ctx))
+}
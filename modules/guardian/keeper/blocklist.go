@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// SetFrozenAccount freezes the account, the txs signed by which are rejected by the ante handler
+func (k Keeper) SetFrozenAccount(ctx sdk.Context, account types.FrozenAccount) {
+	store := ctx.KVStore(k.storeKey)
+	address, _ := sdk.AccAddressFromBech32(account.Address)
+	store.Set(types.GetFrozenAccountKey(address), k.cdc.MustMarshalBinaryBare(&account))
+}
+
+// DeleteFrozenAccount unfreezes the account
+func (k Keeper) DeleteFrozenAccount(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFrozenAccountKey(address))
+}
+
+// GetFrozenAccount retrieves the frozen account of the address
+func (k Keeper) GetFrozenAccount(ctx sdk.Context, address sdk.AccAddress) (account types.FrozenAccount, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFrozenAccountKey(address))
+	if bz == nil {
+		return account, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &account)
+	return account, true
+}
+
+// IsAccountFrozen returns true if the account of the address is frozen
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, address sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetFrozenAccountKey(address))
+}
+
+// IterateFrozenAccounts iterates through all frozen accounts
+func (k Keeper) IterateFrozenAccounts(
+	ctx sdk.Context,
+	op func(account types.FrozenAccount) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.FrozenAccountKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var account types.FrozenAccount
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &account)
+
+		if stop := op(account); stop {
+			break
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func (suite *KeeperTestSuite) TestFreezeAccount() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	frozenAddr := sdk.AccAddress("frozen______________")
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("admin", types.Ordinary, addrs[1], addrs[0], types.RoleBlocklistAdmin))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("breaker", types.Ordinary, addrs[2], addrs[0], types.RoleCircuitBreaker))
+
+	// only the supers granted the blocklist admin role can freeze accounts
+	_, err := msgServer.FreezeAccount(ctx, types.NewMsgFreezeAccount(frozenAddr, "stolen key", addrs[2]))
+	suite.Error(err)
+
+	_, err = msgServer.FreezeAccount(ctx, types.NewMsgFreezeAccount(frozenAddr, "stolen key", addrs[1]))
+	suite.NoError(err)
+	account, found := suite.keeper.GetFrozenAccount(suite.ctx, frozenAddr)
+	suite.True(found)
+	suite.Equal(types.NewFrozenAccount(frozenAddr, addrs[1], "stolen key", suite.ctx.BlockHeight()), account)
+
+	_, err = msgServer.FreezeAccount(ctx, types.NewMsgFreezeAccount(frozenAddr, "", addrs[0]))
+	suite.Error(err)
+
+	// the supers can't be frozen
+	_, err = msgServer.FreezeAccount(ctx, types.NewMsgFreezeAccount(addrs[2], "", addrs[1]))
+	suite.Error(err)
+
+	// the frozen accounts can't be added as supers
+	_, err = msgServer.AddSuper(ctx, types.NewMsgAddSuper("frozen", frozenAddr, addrs[0]))
+	suite.Error(err)
+
+	_, err = msgServer.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(frozenAddr, addrs[2]))
+	suite.Error(err)
+	_, err = msgServer.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(frozenAddr, addrs[0]))
+	suite.NoError(err)
+	suite.False(suite.keeper.IsAccountFrozen(suite.ctx, frozenAddr))
+
+	_, err = msgServer.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(frozenAddr, addrs[0]))
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestBlocklistDecorator() {
+	decorator := keeper.NewBlocklistDecorator(suite.keeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	tx := mockTx{msgs: []sdk.Msg{
+		banktypes.NewMsgSend(addrs[0], addrs[1], coins),
+		banktypes.NewMsgSend(addrs[1], addrs[2], coins),
+	}}
+
+	_, err := decorator.AnteHandle(suite.ctx, tx, false, next)
+	suite.NoError(err)
+
+	// any frozen signer rejects the tx
+	suite.keeper.SetFrozenAccount(suite.ctx, types.NewFrozenAccount(addrs[1], addrs[0], "", 1))
+	_, err = decorator.AnteHandle(suite.ctx, tx, false, next)
+	suite.True(types.ErrAccountFrozen.Is(err))
+
+	// the frozen accounts can still receive
+	_, err = decorator.AnteHandle(suite.ctx, mockTx{msgs: []sdk.Msg{tx.msgs[0]}}, false, next)
+	suite.NoError(err)
+
+	suite.keeper.DeleteFrozenAccount(suite.ctx, addrs[1])
+	_, err = decorator.AnteHandle(suite.ctx, tx, false, next)
+	suite.NoError(err)
+}
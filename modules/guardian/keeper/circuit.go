@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// DisableMsgType disables the msg type, the txs containing msgs of the type are rejected by the ante handler
+func (k Keeper) DisableMsgType(ctx sdk.Context, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDisabledMsgTypeKey(msgTypeURL), []byte{0x01})
+}
+
+// EnableMsgType re-enables the disabled msg type
+func (k Keeper) EnableMsgType(ctx sdk.Context, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDisabledMsgTypeKey(msgTypeURL))
+}
+
+// IsMsgTypeDisabled returns true if the msg type is disabled
+func (k Keeper) IsMsgTypeDisabled(ctx sdk.Context, msgTypeURL string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDisabledMsgTypeKey(msgTypeURL))
+}
+
+// IterateDisabledMsgTypes iterates through the disabled msg types in the order of the type urls
+func (k Keeper) IterateDisabledMsgTypes(
+	ctx sdk.Context,
+	op func(msgTypeURL string) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DisabledMsgTypeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		msgTypeURL := string(iterator.Key()[len(types.DisabledMsgTypeKey):])
+
+		if stop := op(msgTypeURL); stop {
+			break
+		}
+	}
+}
+
+// GetDisabledMsgTypes returns the type urls of all disabled msg types
+func (k Keeper) GetDisabledMsgTypes(ctx sdk.Context) []string {
+	msgTypeURLs := []string{}
+	k.IterateDisabledMsgTypes(
+		ctx,
+		func(msgTypeURL string) bool {
+			msgTypeURLs = append(msgTypeURLs, msgTypeURL)
+			return false
+		},
+	)
+	return msgTypeURLs
+}
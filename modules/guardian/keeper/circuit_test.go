@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+var msgSendTypeURL = types.MsgTypeURL(&banktypes.MsgSend{})
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func (suite *KeeperTestSuite) TestCircuitBreaker() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("breaker", types.Ordinary, addrs[1], addrs[0], types.RoleCircuitBreaker))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("admin", types.Ordinary, addrs[2], addrs[0], types.RoleTokenAdmin))
+
+	// only the supers granted the circuit breaker role can disable msg types
+	_, err := msgServer.DisableMsgTypes(ctx, types.NewMsgDisableMsgTypes([]string{msgSendTypeURL}, addrs[2]))
+	suite.Error(err)
+
+	_, err = msgServer.DisableMsgTypes(ctx, types.NewMsgDisableMsgTypes([]string{msgSendTypeURL}, addrs[1]))
+	suite.NoError(err)
+	suite.True(suite.keeper.IsMsgTypeDisabled(suite.ctx, msgSendTypeURL))
+	suite.Equal([]string{msgSendTypeURL}, suite.keeper.GetDisabledMsgTypes(suite.ctx))
+
+	_, err = msgServer.DisableMsgTypes(ctx, types.NewMsgDisableMsgTypes([]string{msgSendTypeURL}, addrs[0]))
+	suite.Error(err)
+
+	// the genesis supers hold all the roles
+	_, err = msgServer.EnableMsgTypes(ctx, types.NewMsgEnableMsgTypes([]string{msgSendTypeURL}, addrs[2]))
+	suite.Error(err)
+	_, err = msgServer.EnableMsgTypes(ctx, types.NewMsgEnableMsgTypes([]string{msgSendTypeURL}, addrs[0]))
+	suite.NoError(err)
+	suite.False(suite.keeper.IsMsgTypeDisabled(suite.ctx, msgSendTypeURL))
+	suite.Empty(suite.keeper.GetDisabledMsgTypes(suite.ctx))
+
+	_, err = msgServer.EnableMsgTypes(ctx, types.NewMsgEnableMsgTypes([]string{msgSendTypeURL}, addrs[0]))
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreakerDecorator() {
+	decorator := keeper.NewCircuitBreakerDecorator(suite.keeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	msgSend := banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	msgUpdate := types.NewMsgUpdateSuper(addrs[0], "genesis", types.DoNotModify, types.DoNotModify)
+	tx := mockTx{msgs: []sdk.Msg{msgUpdate, msgSend}}
+
+	_, err := decorator.AnteHandle(suite.ctx, tx, false, next)
+	suite.NoError(err)
+
+	suite.keeper.DisableMsgType(suite.ctx, msgSendTypeURL)
+	_, err = decorator.AnteHandle(suite.ctx, tx, false, next)
+	suite.True(types.ErrMsgTypeDisabled.Is(err))
+	_, err = decorator.AnteHandle(suite.ctx, mockTx{msgs: []sdk.Msg{msgUpdate}}, false, next)
+	suite.NoError(err)
+
+	suite.keeper.EnableMsgType(suite.ctx, msgSendTypeURL)
+	_, err = decorator.AnteHandle(suite.ctx, tx, false, next)
+	suite.NoError(err)
+}
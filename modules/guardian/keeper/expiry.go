@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// PruneExpiredSupers deletes the supers expired at the current block and returns them
+func (k Keeper) PruneExpiredSupers(ctx sdk.Context) (pruned []types.Super) {
+	var addresses []sdk.AccAddress
+	k.IterateExpiryQueues(ctx, func(address sdk.AccAddress) bool {
+		addresses = append(addresses, address)
+		return false
+	})
+
+	for _, address := range addresses {
+		// the same super may be queued by both its expiry time and height
+		super, found := k.GetSuper(ctx, address)
+		if !found || !super.Expired(ctx.BlockTime(), ctx.BlockHeight()) {
+			continue
+		}
+		k.DeleteSuper(ctx, address)
+		k.AppendAuditRecord(ctx, types.OperationExpireSuper, "", super.Address)
+		pruned = append(pruned, super)
+	}
+	return pruned
+}
+
+// IterateExpiryQueues iterates through the addresses of the supers queued to expire
+// at or before the current block time and height
+func (k Keeper) IterateExpiryQueues(ctx sdk.Context, op func(address sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	heightIterator := store.Iterator(
+		types.ExpiryHeightQueueKey,
+		sdk.PrefixEndBytes(types.GetExpiryHeightQueuePrefix(ctx.BlockHeight())),
+	)
+	defer heightIterator.Close()
+
+	for ; heightIterator.Valid(); heightIterator.Next() {
+		if op(heightIterator.Value()) {
+			return
+		}
+	}
+
+	timeIterator := store.Iterator(
+		types.ExpiryTimeQueueKey,
+		sdk.PrefixEndBytes(types.GetExpiryTimeQueuePrefix(ctx.BlockTime())),
+	)
+	defer timeIterator.Close()
+
+	for ; timeIterator.Valid(); timeIterator.Next() {
+		if op(timeIterator.Value()) {
+			return
+		}
+	}
+}
+
+func (k Keeper) insertIntoExpiryQueues(ctx sdk.Context, super types.Super, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if super.ExpiryHeight > 0 {
+		store.Set(types.GetExpiryHeightQueueKey(super.ExpiryHeight, address), address)
+	}
+	if super.ExpiryTime != nil {
+		store.Set(types.GetExpiryTimeQueueKey(*super.ExpiryTime, address), address)
+	}
+}
+
+func (k Keeper) removeFromExpiryQueues(ctx sdk.Context, super types.Super, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if super.ExpiryHeight > 0 {
+		store.Delete(types.GetExpiryHeightQueueKey(super.ExpiryHeight, address))
+	}
+	if super.ExpiryTime != nil {
+		store.Delete(types.GetExpiryTimeQueueKey(*super.ExpiryTime, address))
+	}
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func (suite *KeeperTestSuite) TestExpiredSuperUnauthorized() {
+	now := time.Unix(1600000000, 0).UTC()
+	expiry := now.Add(time.Hour)
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(now)
+
+	suite.keeper.AddSuper(ctx, types.NewSuper("by height", types.Ordinary, addrs[0], addrs[0], types.RoleOracleOperator).WithExpiry(nil, 20))
+	suite.keeper.AddSuper(ctx, types.NewSuper("by time", types.Ordinary, addrs[1], addrs[0], types.RoleOracleOperator).WithExpiry(&expiry, 0))
+
+	suite.True(suite.keeper.Authorized(ctx, addrs[0]))
+	suite.True(suite.keeper.AuthorizedFor(ctx, addrs[1], types.RoleOracleOperator))
+
+	// expired but not pruned yet
+	ctx = ctx.WithBlockHeight(20)
+	suite.False(suite.keeper.Authorized(ctx, addrs[0]))
+	suite.False(suite.keeper.AuthorizedFor(ctx, addrs[0], types.RoleOracleOperator))
+	suite.True(suite.keeper.Authorized(ctx, addrs[1]))
+
+	ctx = ctx.WithBlockTime(expiry)
+	suite.False(suite.keeper.Authorized(ctx, addrs[1]))
+	suite.False(keeper.NewRoleAuthorizer(suite.keeper, types.RoleOracleOperator).Authorized(ctx, addrs[1]))
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredSupers() {
+	now := time.Unix(1600000000, 0).UTC()
+	expiry := now.Add(time.Hour)
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(now)
+
+	byHeight := types.NewSuper("by height", types.Ordinary, addrs[0], addrs[0]).WithExpiry(nil, 20)
+	byTime := types.NewSuper("by time", types.Ordinary, addrs[1], addrs[0]).WithExpiry(&expiry, 30)
+	suite.keeper.AddSuper(ctx, byHeight)
+	suite.keeper.AddSuper(ctx, byTime)
+	suite.keeper.AddSuper(ctx, types.NewSuper("forever", types.Genesis, addrs[2], addrs[2]))
+
+	suite.Empty(suite.keeper.PruneExpiredSupers(ctx))
+
+	ctx = ctx.WithBlockHeight(20)
+	suite.Equal([]types.Super{byHeight}, suite.keeper.PruneExpiredSupers(ctx))
+	_, found := suite.keeper.GetSuper(ctx, addrs[0])
+	suite.False(found)
+
+	// the expiry time comes first
+	ctx = ctx.WithBlockHeight(21).WithBlockTime(expiry.Add(time.Second))
+	suite.Equal([]types.Super{byTime}, suite.keeper.PruneExpiredSupers(ctx))
+
+	// the queues are emptied
+	ctx = ctx.WithBlockHeight(30)
+	suite.Empty(suite.keeper.PruneExpiredSupers(ctx))
+	var queued []sdk.AccAddress
+	suite.keeper.IterateExpiryQueues(ctx.WithBlockHeight(1000).WithBlockTime(expiry.Add(time.Hour)), func(address sdk.AccAddress) bool {
+		queued = append(queued, address)
+		return false
+	})
+	suite.Empty(queued)
+
+	_, found = suite.keeper.GetSuper(ctx, addrs[2])
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestReplaceExpiringSuper() {
+	ctx := suite.ctx.WithBlockHeight(10)
+
+	super := types.NewSuper("test", types.Ordinary, addrs[0], addrs[0]).WithExpiry(nil, 20)
+	suite.keeper.AddSuper(ctx, super)
+
+	// the super is replaced without expiry, so it is no longer queued
+	suite.keeper.AddSuper(ctx, super.WithExpiry(nil, 0))
+	suite.Empty(suite.keeper.PruneExpiredSupers(ctx.WithBlockHeight(20)))
+	suite.True(suite.keeper.Authorized(ctx.WithBlockHeight(20), addrs[0]))
+
+	// the queued entry is removed with the super
+	suite.keeper.AddSuper(ctx, super)
+	suite.keeper.DeleteSuper(ctx, addrs[0])
+	suite.keeper.AddSuper(ctx, super.WithExpiry(nil, 0))
+	suite.Empty(suite.keeper.PruneExpiredSupers(ctx.WithBlockHeight(20)))
+}
+
+func (suite *KeeperTestSuite) TestAddExpiringSuper() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := suite.ctx.WithBlockHeight(10)
+
+	suite.keeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+
+	_, err := msgServer.AddSuper(sdk.WrapSDKContext(ctx), types.NewMsgAddSuper("expired", addrs[1], addrs[0]).WithExpiry(nil, 10))
+	suite.Error(err)
+
+	_, err = msgServer.AddSuper(sdk.WrapSDKContext(ctx), types.NewMsgAddSuper("expiring", addrs[1], addrs[0]).WithExpiry(nil, 11))
+	suite.NoError(err)
+
+	super, found := suite.keeper.GetSuper(ctx, addrs[1])
+	suite.True(found)
+	suite.Equal(int64(11), super.ExpiryHeight)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// GetFeeExemptTxCount returns the number of the fee exempt txs sent by the signer in the current block
+func (k Keeper) GetFeeExemptTxCount(ctx sdk.Context, signer sdk.AccAddress) uint32 {
+	store := ctx.TransientStore(k.tkey)
+	bz := store.Get(types.GetFeeExemptionKey(signer))
+	if bz == nil {
+		return 0
+	}
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+// IncreaseFeeExemptTxCount increases the number of the fee exempt txs sent by the signer in the current block,
+// the counts are reset when the block is committed
+func (k Keeper) IncreaseFeeExemptTxCount(ctx sdk.Context, signer sdk.AccAddress) {
+	store := ctx.TransientStore(k.tkey)
+	count := k.GetFeeExemptTxCount(ctx, signer) + 1
+	store.Set(types.GetFeeExemptionKey(signer), sdk.Uint64ToBigEndian(uint64(count)))
+}
+
+// FeeExemptSigners returns the signers of the tx if it is fee exempt, i.e. all its msgs are of the
+// allow-listed types, all its signers and its fee payer are supers granted the fee exempt role which
+// haven't used up their exempt txs of the current block
+func (k Keeper) FeeExemptSigners(ctx sdk.Context, tx sdk.Tx, params types.Params) ([]sdk.AccAddress, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 || params.MaxFeeExemptTxsPerBlock == 0 {
+		return nil, false
+	}
+
+	var signers []sdk.AccAddress
+	seen := make(map[string]bool)
+	for _, msg := range msgs {
+		if !params.IsFeeExemptMsgType(types.MsgTypeURL(msg)) {
+			return nil, false
+		}
+		for _, signer := range msg.GetSigners() {
+			if !seen[signer.String()] {
+				seen[signer.String()] = true
+				signers = append(signers, signer)
+			}
+		}
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok && !seen[feeTx.FeePayer().String()] {
+		signers = append(signers, feeTx.FeePayer())
+	}
+
+	for _, signer := range signers {
+		if !k.AuthorizedFor(ctx, signer, params.FeeExemptRole) || k.GetFeeExemptTxCount(ctx, signer) >= params.MaxFeeExemptTxsPerBlock {
+			return nil, false
+		}
+	}
+
+	return signers, true
+}
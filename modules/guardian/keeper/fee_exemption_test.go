@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeExemptionDecorator() {
+	decorator := keeper.NewFeeExemptionDecorator(suite.keeper)
+
+	var minGasPrices sdk.DecCoins
+	var discount sdk.Dec
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		minGasPrices = ctx.MinGasPrices()
+		discount = keeper.FeeDiscount(ctx)
+		return ctx, nil
+	}
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(1, time.Hour, []string{msgSendTypeURL}, sdk.NewDecWithPrec(75, 2), 2, types.RoleOracleOperator))
+
+	prices := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	discounted := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(25)))
+	ctx := suite.ctx.WithBlockHeight(10).WithMinGasPrices(prices)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	tx := mockTx{msgs: []sdk.Msg{banktypes.NewMsgSend(addrs[0], addrs[1], coins)}}
+
+	// the allow-listed msgs signed by a super are discounted up to the rate limit
+	for i := 0; i < 2; i++ {
+		_, err := decorator.AnteHandle(ctx, tx, false, next)
+		suite.NoError(err)
+		suite.Equal(discounted, minGasPrices)
+		suite.Equal(sdk.NewDecWithPrec(75, 2), discount)
+	}
+	suite.Equal(uint32(2), suite.keeper.GetFeeExemptTxCount(ctx, addrs[0]))
+
+	_, err := decorator.AnteHandle(ctx, tx, false, next)
+	suite.NoError(err)
+	suite.Equal(prices, minGasPrices)
+	suite.True(discount.IsZero())
+	suite.Equal(uint32(2), suite.keeper.GetFeeExemptTxCount(ctx, addrs[0]))
+
+	// the counts are kept out of the persistent store
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.FeeExemptionKey)
+	suite.False(iterator.Valid())
+	iterator.Close()
+
+	// the rate limit is reset when the block is committed
+	suite.app.Commit()
+	header := tmproto.Header{Height: suite.app.LastBlockHeight() + 1}
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx = suite.app.BaseApp.NewContext(false, header).WithMinGasPrices(prices)
+	suite.Equal(uint32(0), suite.keeper.GetFeeExemptTxCount(ctx, addrs[0]))
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	suite.NoError(err)
+	suite.Equal(discounted, minGasPrices)
+
+	// the msgs signed by non supers aren't discounted
+	_, err = decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{banktypes.NewMsgSend(addrs[1], addrs[0], coins)}}, false, next)
+	suite.NoError(err)
+	suite.Equal(prices, minGasPrices)
+
+	// nor the msgs signed by supers without the fee exempt role
+	suite.keeper.AddSuper(ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0], types.RoleServiceAdmin))
+	_, err = decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{banktypes.NewMsgSend(addrs[1], addrs[0], coins)}}, false, next)
+	suite.NoError(err)
+	suite.Equal(prices, minGasPrices)
+
+	suite.keeper.AddSuper(ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0], types.RoleOracleOperator))
+	_, err = decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{banktypes.NewMsgSend(addrs[1], addrs[0], coins)}}, false, next)
+	suite.NoError(err)
+	suite.Equal(discounted, minGasPrices)
+
+	// neither are the txs containing a msg not allow-listed
+	msgUpdate := types.NewMsgUpdateSuper(addrs[0], "genesis", types.DoNotModify, types.DoNotModify)
+	_, err = decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{tx.msgs[0], msgUpdate}}, false, next)
+	suite.NoError(err)
+	suite.Equal(prices, minGasPrices)
+
+	// a full discount makes the exempt txs gas-free
+	suite.keeper.SetParams(ctx, types.NewParams(1, time.Hour, []string{msgSendTypeURL}, sdk.OneDec(), 2, types.RoleOracleOperator))
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	suite.NoError(err)
+	suite.True(minGasPrices.IsZero())
+
+	// no tx is exempt when the rate limit is zero
+	suite.keeper.SetParams(ctx, types.NewParams(1, time.Hour, []string{msgSendTypeURL}, sdk.OneDec(), 0, types.RoleOracleOperator))
+	_, err = decorator.AnteHandle(ctx.WithBlockHeight(12), tx, false, next)
+	suite.NoError(err)
+	suite.Equal(prices, minGasPrices)
+}
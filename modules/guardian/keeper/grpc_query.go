@@ -6,7 +6,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
@@ -19,15 +21,194 @@ func (k Keeper) Supers(c context.Context, req *types.QuerySupersRequest) (*types
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
 
+	filter, err := types.NewSuperFilter(req.Role, req.AccountType, req.AddedBy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSupersSubspaceKey())
+
 	var supers []types.Super
-	k.IterateSupers(
-		ctx,
-		func(super types.Super) bool {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var super types.Super
+		if err := k.cdc.UnmarshalBinaryBare(value, &super); err != nil {
+			return false, err
+		}
+
+		if !filter.Match(super) {
+			return false, nil
+		}
+		if accumulate {
 			supers = append(supers, super)
-			return false
-		},
-	)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupersResponse{Supers: supers, Pagination: pageRes}, nil
+}
+
+// Super implements the Query/Super gRPC method
+func (k Keeper) Super(c context.Context, req *types.QuerySuperRequest) (*types.QuerySuperResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	super, found := k.GetSuper(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "super %s not found", req.Address)
+	}
+
+	return &types.QuerySuperResponse{Super: super}, nil
+}
+
+// PendingActions implements the Query/PendingActions gRPC method
+func (k Keeper) PendingActions(c context.Context, req *types.QueryPendingActionsRequest) (*types.QueryPendingActionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingActionKey)
+
+	var actions []types.PendingAction
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var action types.PendingAction
+		if err := k.cdc.UnmarshalBinaryBare(value, &action); err != nil {
+			return err
+		}
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingActionsResponse{PendingActions: actions, Pagination: pageRes}, nil
+}
+
+// PendingAction implements the Query/PendingAction gRPC method
+func (k Keeper) PendingAction(c context.Context, req *types.QueryPendingActionRequest) (*types.QueryPendingActionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
 
-	return &types.QuerySupersResponse{Supers: supers}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	action, found := k.GetPendingAction(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pending action %d not found", req.Id)
+	}
+
+	return &types.QueryPendingActionResponse{PendingAction: action}, nil
+}
+
+// AuditLog implements the Query/AuditLog gRPC method
+func (k Keeper) AuditLog(c context.Context, req *types.QueryAuditLogRequest) (*types.QueryAuditLogResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Target) > 0 {
+		if _, err := sdk.AccAddressFromBech32(req.Target); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid target: %s", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuditLogKey)
+
+	var records []types.AuditRecord
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var record types.AuditRecord
+		if err := k.cdc.UnmarshalBinaryBare(value, &record); err != nil {
+			return false, err
+		}
+
+		if len(req.Target) > 0 && record.Target != req.Target {
+			return false, nil
+		}
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuditLogResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// DisabledMsgTypes implements the Query/DisabledMsgTypes gRPC method
+func (k Keeper) DisabledMsgTypes(c context.Context, req *types.QueryDisabledMsgTypesRequest) (*types.QueryDisabledMsgTypesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryDisabledMsgTypesResponse{MsgTypeUrls: k.GetDisabledMsgTypes(ctx)}, nil
+}
+
+// FrozenAccounts implements the Query/FrozenAccounts gRPC method
+func (k Keeper) FrozenAccounts(c context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FrozenAccountKey)
+
+	var accounts []types.FrozenAccount
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var account types.FrozenAccount
+		if err := k.cdc.UnmarshalBinaryBare(value, &account); err != nil {
+			return err
+		}
+		accounts = append(accounts, account)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenAccountsResponse{FrozenAccounts: accounts, Pagination: pageRes}, nil
+}
+
+// FrozenAccount implements the Query/FrozenAccount gRPC method
+func (k Keeper) FrozenAccount(c context.Context, req *types.QueryFrozenAccountRequest) (*types.QueryFrozenAccountResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	account, found := k.GetFrozenAccount(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "frozen account %s not found", req.Address)
+	}
+
+	return &types.QueryFrozenAccountResponse{FrozenAccount: account}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
@@ -27,4 +28,108 @@ func (suite *KeeperTestSuite) TestGRPCQuerySupers() {
 	suite.Require().NoError(err)
 	suite.Len(supersResp.Supers, 1)
 	suite.Equal(guardian, supersResp.Supers[0])
+
+	_, _, oracleAddr := testdata.KeyTestPubAddr()
+	oracle := types.NewSuper("oracle", types.Ordinary, oracleAddr, addr, types.RoleOracleOperator)
+	app.GuardianKeeper.AddSuper(ctx, oracle)
+
+	supersResp, err = queryClient.Supers(gocontext.Background(), &types.QuerySupersRequest{Role: types.RoleOracleOperator})
+	suite.Require().NoError(err)
+	suite.Len(supersResp.Supers, 1)
+	suite.Equal(oracle, supersResp.Supers[0])
+
+	_, err = queryClient.Supers(gocontext.Background(), &types.QuerySupersRequest{Role: types.Role(10)})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySupersFilters() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0]))
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("ordinary", types.Ordinary, addrs[2], addrs[1]))
+
+	testCases := []struct {
+		msg      string
+		req      *types.QuerySupersRequest
+		expected int
+	}{
+		{"all", &types.QuerySupersRequest{}, 3},
+		{"genesis", &types.QuerySupersRequest{AccountType: "Genesis"}, 1},
+		{"ordinary", &types.QuerySupersRequest{AccountType: "Ordinary"}, 2},
+		{"added by", &types.QuerySupersRequest{AddedBy: addrs[0].String()}, 2},
+		{"ordinary added by", &types.QuerySupersRequest{AccountType: "Ordinary", AddedBy: addrs[0].String()}, 1},
+		{"paginated", &types.QuerySupersRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}}, 2},
+		{"paginated ordinary", &types.QuerySupersRequest{AccountType: "Ordinary", Pagination: &query.PageRequest{Offset: 1, Limit: 2}}, 1},
+	}
+	for _, tc := range testCases {
+		supersResp, err := queryClient.Supers(gocontext.Background(), tc.req)
+		suite.Require().NoError(err, tc.msg)
+		suite.Len(supersResp.Supers, tc.expected, tc.msg)
+	}
+
+	supersResp, err := queryClient.Supers(gocontext.Background(), &types.QuerySupersRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Equal(uint64(3), supersResp.Pagination.Total)
+	suite.NotNil(supersResp.Pagination.NextKey)
+
+	_, err = queryClient.Supers(gocontext.Background(), &types.QuerySupersRequest{AccountType: "Admin"})
+	suite.Require().Error(err)
+	_, err = queryClient.Supers(gocontext.Background(), &types.QuerySupersRequest{AddedBy: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySuper() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	super := types.NewSuper("test", types.Ordinary, addrs[1], addrs[0], types.RoleServiceAdmin)
+	app.GuardianKeeper.AddSuper(ctx, super)
+
+	superResp, err := queryClient.Super(gocontext.Background(), &types.QuerySuperRequest{Address: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Equal(super, superResp.Super)
+
+	_, err = queryClient.Super(gocontext.Background(), &types.QuerySuperRequest{Address: addrs[2].String()})
+	suite.Require().Error(err)
+	_, err = queryClient.Super(gocontext.Background(), &types.QuerySuperRequest{Address: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryAuditLog() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	app.GuardianKeeper.AppendAuditRecord(ctx, types.OperationAddSuper, addrs[0].String(), addrs[1].String())
+	app.GuardianKeeper.AppendAuditRecord(ctx, types.OperationAddSuper, addrs[0].String(), addrs[2].String())
+	app.GuardianKeeper.AppendAuditRecord(ctx, types.OperationDeleteSuper, addrs[0].String(), addrs[1].String())
+
+	auditResp, err := queryClient.AuditLog(gocontext.Background(), &types.QueryAuditLogRequest{})
+	suite.Require().NoError(err)
+	suite.Len(auditResp.Records, 3)
+
+	auditResp, err = queryClient.AuditLog(gocontext.Background(), &types.QueryAuditLogRequest{Target: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Len(auditResp.Records, 2)
+	suite.Equal(types.OperationDeleteSuper, auditResp.Records[1].Operation)
+
+	auditResp, err = queryClient.AuditLog(gocontext.Background(), &types.QueryAuditLogRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Len(auditResp.Records, 2)
+	suite.Equal(uint64(3), auditResp.Pagination.Total)
+
+	_, err = queryClient.AuditLog(gocontext.Background(), &types.QueryAuditLogRequest{Target: "invalid"})
+	suite.Require().Error(err)
 }
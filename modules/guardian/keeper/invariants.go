@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// RegisterInvariants registers all guardian invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supers", SupersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "known-adders", KnownAddersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "genesis-super", GenesisSuperInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "genesis-threshold", GenesisThresholdInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pending-actions", PendingActionsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the guardian module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			SupersInvariant(k),
+			KnownAddersInvariant(k),
+			GenesisSuperInvariant(k),
+			GenesisThresholdInvariant(k),
+			PendingActionsInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// SupersInvariant checks that the stored supers are valid and stored under their own addresses
+func SupersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		k.IterateSupers(ctx, func(super types.Super) bool {
+			if err := super.Validate(); err != nil {
+				msg += fmt.Sprintf("\tsuper %s is invalid: %s\n", super.Address, err)
+				count++
+				return false
+			}
+			address, _ := sdk.AccAddressFromBech32(super.Address)
+			if stored, found := k.GetSuper(ctx, address); !found || !stored.Equal(super) {
+				msg += fmt.Sprintf("\tsuper %s is not stored under its address\n", super.Address)
+				count++
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "supers", fmt.Sprintf(
+			"%d invalid supers found\n%s", count, msg,
+		)), broken
+	}
+}
+
+// KnownAddersInvariant checks that the ordinary supers are added by the supers, the gov module
+// account, or the former supers recorded in the audit log
+func KnownAddersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		supers := k.getAllSupers(ctx)
+
+		var auditLog []types.AuditRecord
+		k.IterateAuditLog(ctx, func(record types.AuditRecord) bool {
+			auditLog = append(auditLog, record)
+			return false
+		})
+
+		err := types.ValidateAdders(supers, types.KnownAdders(supers, auditLog))
+		broken := err != nil
+
+		return sdk.FormatInvariant(types.ModuleName, "known-adders", fmt.Sprintf(
+			"\tsupers: %d\n\terror: %v\n", len(supers), err,
+		)), broken
+	}
+}
+
+// GenesisSuperInvariant checks that a genesis super exists if any ordinary super does
+func GenesisSuperInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		supers := k.getAllSupers(ctx)
+		broken := types.ValidateGenesisSuperExists(supers) != nil
+
+		return sdk.FormatInvariant(types.ModuleName, "genesis-super", fmt.Sprintf(
+			"\tsupers: %d\n\tgenesis supers: %d\n", len(supers), k.GetGenesisSuperCount(ctx),
+		)), broken
+	}
+}
+
+// GenesisThresholdInvariant checks that the genesis supers can meet the threshold if any super exists
+func GenesisThresholdInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		supers := k.getAllSupers(ctx)
+
+		// the params may not be set yet when the crisis module asserts the invariants at genesis
+		var threshold uint32
+		if len(supers) > 0 {
+			threshold = k.GetParams(ctx).GenesisThreshold
+		}
+		broken := types.ValidateGenesisThreshold(supers, threshold) != nil
+
+		return sdk.FormatInvariant(types.ModuleName, "genesis-threshold", fmt.Sprintf(
+			"\tsupers: %d\n\tgenesis supers: %d\n\tgenesis threshold: %d\n", len(supers), k.GetGenesisSuperCount(ctx), threshold,
+		)), broken
+	}
+}
+
+// PendingActionsInvariant checks that the pending actions are valid and their ids are below the next action id
+func PendingActionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		nextID := k.GetNextActionID(ctx)
+
+		var msg string
+		var count int
+		k.IteratePendingActions(ctx, func(action types.PendingAction) bool {
+			if err := action.Validate(); err != nil {
+				msg += fmt.Sprintf("\tpending action %d is invalid: %s\n", action.Id, err)
+				count++
+			} else if action.Id >= nextID {
+				msg += fmt.Sprintf("\tpending action %d is not below the next action id %d\n", action.Id, nextID)
+				count++
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "pending-actions", fmt.Sprintf(
+			"%d invalid pending actions found\n%s", count, msg,
+		)), broken
+	}
+}
+
+func (k Keeper) getAllSupers(ctx sdk.Context) (supers []types.Super) {
+	k.IterateSupers(ctx, func(super types.Super) bool {
+		supers = append(supers, super)
+		return false
+	})
+	return supers
+}
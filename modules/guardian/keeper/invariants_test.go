@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func (suite *KeeperTestSuite) TestSupersInvariant() {
+	invariant := keeper.SupersInvariant(suite.keeper)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	_, broken := invariant(suite.ctx)
+	suite.False(broken)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0], types.RoleUnspecified))
+	_, broken = invariant(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *KeeperTestSuite) TestKnownAddersInvariant() {
+	invariant := keeper.KnownAddersInvariant(suite.keeper)
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	_, err := msgServer.AddSuper(sdk.WrapSDKContext(suite.ctx), types.NewMsgAddSuper("ordinary", addrs[1], addrs[0]))
+	suite.NoError(err)
+	_, broken := invariant(suite.ctx)
+	suite.False(broken)
+
+	// the adder deleted by governance is still known from the audit log
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[2], addrs[2]))
+	suite.NoError(keeper.HandleDeleteSuperProposal(suite.ctx, suite.keeper, types.NewDeleteSuperProposal("title", "description", addrs[0])))
+	_, broken = invariant(suite.ctx)
+	suite.False(broken)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], sdk.AccAddress("unknown_adder_______")))
+	_, broken = invariant(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *KeeperTestSuite) TestGenesisSuperInvariant() {
+	invariant := keeper.GenesisSuperInvariant(suite.keeper)
+
+	_, broken := invariant(suite.ctx)
+	suite.False(broken)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0]))
+	_, broken = invariant(suite.ctx)
+	suite.True(broken)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	_, broken = invariant(suite.ctx)
+	suite.False(broken)
+}
+
+func (suite *KeeperTestSuite) TestGenesisThresholdInvariant() {
+	invariant := keeper.GenesisThresholdInvariant(suite.keeper)
+	suite.keeper.SetParams(suite.ctx, types.NewParams(2, time.Hour, nil, sdk.OneDec(), 10, types.RoleOracleOperator))
+
+	_, broken := invariant(suite.ctx)
+	suite.False(broken)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	_, broken = invariant(suite.ctx)
+	suite.True(broken)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[1], addrs[0]))
+	_, broken = invariant(suite.ctx)
+	suite.False(broken)
+}
+
+func (suite *KeeperTestSuite) TestPendingActionsInvariant() {
+	invariant := keeper.PendingActionsInvariant(suite.keeper)
+
+	action := types.NewDeleteSuperAction(addrs[1], addrs[0], time.Unix(1600000000, 0).UTC())
+	action.Id = 1
+	suite.keeper.SetPendingAction(suite.ctx, action)
+	suite.keeper.SetNextActionID(suite.ctx, 2)
+	_, broken := invariant(suite.ctx)
+	suite.False(broken)
+
+	suite.keeper.SetNextActionID(suite.ctx, 1)
+	_, broken = invariant(suite.ctx)
+	suite.True(broken)
+}
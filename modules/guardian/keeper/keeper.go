@@ -7,21 +7,26 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
 
 // Keeper of the guardian store
 type Keeper struct {
-	cdc      codec.Marshaler
-	storeKey sdk.StoreKey
+	cdc        codec.Marshaler
+	storeKey   sdk.StoreKey
+	tkey       sdk.StoreKey
+	paramSpace paramtypes.Subspace
 }
 
 // NewKeeper returns a guardian keeper
-func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey) Keeper {
+func NewKeeper(cdc codec.Marshaler, key, tkey sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
 	keeper := Keeper{
-		storeKey: key,
-		cdc:      cdc,
+		storeKey:   key,
+		tkey:       tkey,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
 	}
 	return keeper
 }
@@ -36,12 +41,19 @@ func (k Keeper) AddSuper(ctx sdk.Context, super types.Super) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryBare(&super)
 	address, _ := sdk.AccAddressFromBech32(super.Address)
+	if existing, found := k.GetSuper(ctx, address); found {
+		k.removeFromExpiryQueues(ctx, existing, address)
+	}
 	store.Set(types.GetSuperKey(address), bz)
+	k.insertIntoExpiryQueues(ctx, super, address)
 }
 
 // DeleteSuper delete the stored super
 func (k Keeper) DeleteSuper(ctx sdk.Context, address sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
+	if existing, found := k.GetSuper(ctx, address); found {
+		k.removeFromExpiryQueues(ctx, existing, address)
+	}
 	store.Delete(types.GetSuperKey(address))
 }
 
@@ -75,7 +87,71 @@ func (k Keeper) IterateSupers(
 	}
 }
 
+// ValidateGenesisThreshold checks that the genesis supers stored can meet the threshold
+func (k Keeper) ValidateGenesisThreshold(ctx sdk.Context, threshold uint32) error {
+	return types.ValidateGenesisThreshold(k.getAllSupers(ctx), threshold)
+}
+
+// GetGenesisSuperCount returns the number of the genesis supers
+func (k Keeper) GetGenesisSuperCount(ctx sdk.Context) (count int) {
+	k.IterateSupers(
+		ctx,
+		func(super types.Super) bool {
+			if super.AccountType == types.Genesis {
+				count++
+			}
+			return false
+		},
+	)
+	return count
+}
+
+// IsGenesisSuper returns true if the address is an unexpired genesis super
+func (k Keeper) IsGenesisSuper(ctx sdk.Context, addr sdk.AccAddress) bool {
+	super, found := k.GetSuper(ctx, addr)
+	return found && super.AccountType == types.Genesis && !super.Expired(ctx.BlockTime(), ctx.BlockHeight())
+}
+
+// Authorized returns true if the address is an unexpired super of any role
 func (k Keeper) Authorized(ctx sdk.Context, addr sdk.AccAddress) bool {
-	_, found := k.GetSuper(ctx, addr)
-	return found
+	super, found := k.GetSuper(ctx, addr)
+	return found && !super.Expired(ctx.BlockTime(), ctx.BlockHeight())
+}
+
+// AuthorizedFor returns true if the address is an unexpired super granted the specified role
+func (k Keeper) AuthorizedFor(ctx sdk.Context, addr sdk.AccAddress, role types.Role) bool {
+	super, found := k.GetSuper(ctx, addr)
+	return found && !super.Expired(ctx.BlockTime(), ctx.BlockHeight()) && super.HasRole(role)
+}
+
+// RoleAuthorizer authorizes the supers granted a specific role,
+// for the consumer modules which only know about Authorized
+type RoleAuthorizer struct {
+	keeper Keeper
+	role   types.Role
+}
+
+// NewRoleAuthorizer returns a RoleAuthorizer for the specified role
+func NewRoleAuthorizer(keeper Keeper, role types.Role) RoleAuthorizer {
+	return RoleAuthorizer{
+		keeper: keeper,
+		role:   role,
+	}
+}
+
+// Authorized returns true if the address is a super granted the role of the authorizer
+func (a RoleAuthorizer) Authorized(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return a.keeper.AuthorizedFor(ctx, addr, a.role)
+}
+
+// GetParams returns the guardian params from the global param store
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the guardian params to the global param store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
 }
@@ -103,6 +103,101 @@ func (suite *KeeperTestSuite) TestQuerySupers() {
 	suite.NoError(err)
 	suite.Len(supers, 1)
 	suite.Contains(supers, super)
+
+	ordinary := types.NewSuper("test", types.Ordinary, addrs[1], addrs[0], types.RoleUpgradeOperator)
+	suite.keeper.AddSuper(suite.ctx, ordinary)
+
+	bz, err := suite.cdc.MarshalJSON(types.QuerySupersParams{Role: types.RoleOracleOperator})
+	suite.NoError(err)
+	res, sdkErr = querier(suite.ctx, []string{types.QuerySupers}, abci.RequestQuery{Data: bz})
+	suite.NoError(sdkErr)
+
+	supers = nil
+	err = suite.cdc.UnmarshalJSON(res, &supers)
+	suite.NoError(err)
+	suite.Len(supers, 1)
+	suite.Contains(supers, super)
+}
+
+func (suite *KeeperTestSuite) TestAuthorizedFor() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("oracle", types.Ordinary, addrs[1], addrs[0], types.RoleOracleOperator))
+
+	suite.True(suite.keeper.AuthorizedFor(suite.ctx, addrs[0], types.RoleOracleOperator))
+	suite.True(suite.keeper.AuthorizedFor(suite.ctx, addrs[0], types.RoleTokenAdmin))
+	suite.True(suite.keeper.AuthorizedFor(suite.ctx, addrs[1], types.RoleOracleOperator))
+	suite.False(suite.keeper.AuthorizedFor(suite.ctx, addrs[1], types.RoleTokenAdmin))
+	suite.False(suite.keeper.AuthorizedFor(suite.ctx, addrs[2], types.RoleOracleOperator))
+
+	// any super is authorized regardless of its roles
+	suite.True(suite.keeper.Authorized(suite.ctx, addrs[1]))
+
+	authorizer := keeper.NewRoleAuthorizer(suite.keeper, types.RoleServiceAdmin)
+	suite.True(authorizer.Authorized(suite.ctx, addrs[0]))
+	suite.False(authorizer.Authorized(suite.ctx, addrs[1]))
+}
+
+func (suite *KeeperTestSuite) TestSetRoles() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+
+	_, err := msgServer.AddSuper(ctx, types.NewMsgAddSuper("ordinary", addrs[1], addrs[0], types.RoleTokenAdmin))
+	suite.NoError(err)
+	suite.True(suite.keeper.AuthorizedFor(suite.ctx, addrs[1], types.RoleTokenAdmin))
+
+	// only a genesis super can set the roles
+	_, err = msgServer.SetRoles(ctx, types.NewMsgSetRoles(addrs[1], addrs[1], types.RoleOracleOperator))
+	suite.Error(err)
+
+	_, err = msgServer.SetRoles(ctx, types.NewMsgSetRoles(addrs[2], addrs[0], types.RoleOracleOperator))
+	suite.Error(err)
+
+	_, err = msgServer.SetRoles(ctx, types.NewMsgSetRoles(addrs[1], addrs[0], types.RoleOracleOperator, types.RoleServiceAdmin))
+	suite.NoError(err)
+
+	super, found := suite.keeper.GetSuper(suite.ctx, addrs[1])
+	suite.True(found)
+	suite.Equal([]types.Role{types.RoleOracleOperator, types.RoleServiceAdmin}, super.Roles)
+	suite.False(suite.keeper.AuthorizedFor(suite.ctx, addrs[1], types.RoleTokenAdmin))
+
+	// revoke all the roles
+	_, err = msgServer.SetRoles(ctx, types.NewMsgSetRoles(addrs[1], addrs[0]))
+	suite.NoError(err)
+	suite.False(suite.keeper.AuthorizedFor(suite.ctx, addrs[1], types.RoleOracleOperator))
+	suite.True(suite.keeper.Authorized(suite.ctx, addrs[1]))
+}
+
+func (suite *KeeperTestSuite) TestUpdateSuper() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	genesis := types.NewSuper("genesis", types.Genesis, addrs[0], addrs[1], types.RoleOracleOperator)
+	suite.keeper.AddSuper(suite.ctx, genesis)
+
+	_, err := msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper(addrs[0], types.DoNotModify, "https://irisnet.org", "security@irisnet.org"))
+	suite.NoError(err)
+
+	super, found := suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.True(found)
+	suite.Equal("genesis", super.Description)
+	suite.Equal("https://irisnet.org", super.Website)
+	suite.Equal("security@irisnet.org", super.SecurityContact)
+	// the provenance and the roles are kept
+	suite.Equal(addrs[1].String(), super.AddedBy)
+	suite.Equal(genesis.Roles, super.Roles)
+
+	_, err = msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper(addrs[0], "updated", types.DoNotModify, ""))
+	suite.NoError(err)
+	super, _ = suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.Equal("updated", super.Description)
+	suite.Equal("https://irisnet.org", super.Website)
+	suite.Empty(super.SecurityContact)
+
+	// only an existing super can update itself
+	_, err = msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper(addrs[2], "unknown", types.DoNotModify, types.DoNotModify))
+	suite.Error(err)
 }
 
 func newPubKey(pk string) (res crypto.PubKey) {
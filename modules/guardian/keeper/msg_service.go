@@ -31,29 +31,26 @@ func (m msgServer) AddSuper(goCtx context.Context, msg *types.MsgAddSuper) (*typ
 	if err != nil {
 		return nil, err
 	}
-	if super, found := m.Keeper.GetSuper(ctx, addedBy); !found || super.GetAccountType() != types.Genesis {
+	if !m.Keeper.IsGenesisSuper(ctx, addedBy) {
 		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.AddedBy)
 	}
-	if _, found := m.Keeper.GetSuper(ctx, address); found {
-		return nil, sdkerrors.Wrap(types.ErrSuperExists, msg.Address)
+	super := types.NewSuper(msg.Description, types.Ordinary, address, addedBy, msg.Roles...).
+		WithExpiry(msg.ExpiryTime, msg.ExpiryHeight)
+	deadline := ctx.BlockTime().Add(m.Keeper.GetParams(ctx).PendingActionTimeout)
+	actionID, err := m.Keeper.SubmitAction(ctx, types.NewAddSuperAction(super, deadline))
+	if err != nil {
+		return nil, err
 	}
-	super := types.NewSuper(msg.Description, types.Ordinary, address, addedBy)
-	m.Keeper.AddSuper(ctx, super)
 
-	ctx.EventManager().EmitEvents(sdk.Events{
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.AddedBy),
 		),
-		sdk.NewEvent(
-			types.EventTypeAddSuper,
-			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
-			sdk.NewAttribute(types.AttributeKeyAddedBy, msg.AddedBy),
-		),
-	})
+	)
 
-	return &types.MsgAddSuperResponse{}, nil
+	return &types.MsgAddSuperResponse{ActionId: actionID}, nil
 }
 
 func (m msgServer) DeleteSuper(goCtx context.Context, msg *types.MsgDeleteSuper) (*types.MsgDeleteSuperResponse, error) {
@@ -68,31 +65,259 @@ func (m msgServer) DeleteSuper(goCtx context.Context, msg *types.MsgDeleteSuper)
 		return nil, err
 	}
 
-	if super, found := m.Keeper.GetSuper(ctx, deletedBy); !found || super.GetAccountType() != types.Genesis {
+	if !m.Keeper.IsGenesisSuper(ctx, deletedBy) {
 		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.DeletedBy)
 	}
+	deadline := ctx.BlockTime().Add(m.Keeper.GetParams(ctx).PendingActionTimeout)
+	actionID, err := m.Keeper.SubmitAction(ctx, types.NewDeleteSuperAction(address, deletedBy, deadline))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeletedBy),
+		),
+	)
+
+	return &types.MsgDeleteSuperResponse{ActionId: actionID}, nil
+}
+
+func (m msgServer) SetRoles(goCtx context.Context, msg *types.MsgSetRoles) (*types.MsgSetRolesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	setBy, err := sdk.AccAddressFromBech32(msg.SetBy)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.Keeper.IsGenesisSuper(ctx, setBy) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.SetBy)
+	}
+	deadline := ctx.BlockTime().Add(m.Keeper.GetParams(ctx).PendingActionTimeout)
+	actionID, err := m.Keeper.SubmitAction(ctx, types.NewSetRolesAction(address, setBy, msg.Roles, deadline))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.SetBy),
+		),
+	)
+
+	return &types.MsgSetRolesResponse{ActionId: actionID}, nil
+}
+
+func (m msgServer) ApproveAction(goCtx context.Context, msg *types.MsgApproveAction) (*types.MsgApproveActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	approver, err := sdk.AccAddressFromBech32(msg.Approver)
+	if err != nil {
+		return nil, err
+	}
+
+	executed, err := m.Keeper.ApproveAction(ctx, msg.Id, approver)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Approver),
+		),
+	)
+
+	return &types.MsgApproveActionResponse{Executed: executed}, nil
+}
+
+func (m msgServer) UpdateSuper(goCtx context.Context, msg *types.MsgUpdateSuper) (*types.MsgUpdateSuperResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
 	super, found := m.Keeper.GetSuper(ctx, address)
-	if !found {
+	if !found || super.Expired(ctx.BlockTime(), ctx.BlockHeight()) {
 		return nil, sdkerrors.Wrap(types.ErrUnknownSuper, msg.Address)
 	}
-	if super.GetAccountType() == types.Genesis {
-		return nil, sdkerrors.Wrap(types.ErrDeleteGenesisSuper, msg.Address)
-	}
 
-	m.Keeper.DeleteSuper(ctx, address)
+	super = super.UpdateMetadata(msg.Description, msg.Website, msg.SecurityContact)
+	m.Keeper.AddSuper(ctx, super)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeletedBy),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
 		),
 		sdk.NewEvent(
-			types.EventTypeDeleteSuper,
+			types.EventTypeUpdateSuper,
 			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
-			sdk.NewAttribute(types.AttributeKeyDeletedBy, msg.DeletedBy),
+			sdk.NewAttribute(types.AttributeKeyDescription, super.Description),
+			sdk.NewAttribute(types.AttributeKeyWebsite, super.Website),
+			sdk.NewAttribute(types.AttributeKeySecurityContact, super.SecurityContact),
+		),
+	})
+
+	return &types.MsgUpdateSuperResponse{}, nil
+}
+
+func (m msgServer) DisableMsgTypes(goCtx context.Context, msg *types.MsgDisableMsgTypes) (*types.MsgDisableMsgTypesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.AuthorizedFor(ctx, operator, types.RoleCircuitBreaker) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Operator)
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	}
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		if m.Keeper.IsMsgTypeDisabled(ctx, msgTypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrMsgTypeDisabled, "%s already disabled", msgTypeURL)
+		}
+		m.Keeper.DisableMsgType(ctx, msgTypeURL)
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeDisableMsgType,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgDisableMsgTypesResponse{}, nil
+}
+
+func (m msgServer) EnableMsgTypes(goCtx context.Context, msg *types.MsgEnableMsgTypes) (*types.MsgEnableMsgTypesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.AuthorizedFor(ctx, operator, types.RoleCircuitBreaker) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Operator)
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	}
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		if !m.Keeper.IsMsgTypeDisabled(ctx, msgTypeURL) {
+			return nil, sdkerrors.Wrap(types.ErrMsgTypeNotDisabled, msgTypeURL)
+		}
+		m.Keeper.EnableMsgType(ctx, msgTypeURL)
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeEnableMsgType,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgEnableMsgTypesResponse{}, nil
+}
+
+func (m msgServer) FreezeAccount(goCtx context.Context, msg *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.AuthorizedFor(ctx, operator, types.RoleBlocklistAdmin) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Operator)
+	}
+	// a compromised super is to be deleted rather than frozen
+	if _, found := m.Keeper.GetSuper(ctx, address); found {
+		return nil, sdkerrors.Wrap(types.ErrFreezeSuper, msg.Address)
+	}
+	if m.Keeper.IsAccountFrozen(ctx, address) {
+		return nil, sdkerrors.Wrap(types.ErrAccountFrozen, msg.Address)
+	}
+
+	m.Keeper.SetFrozenAccount(ctx, types.NewFrozenAccount(address, operator, msg.Reason, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+		sdk.NewEvent(
+			types.EventTypeFreezeAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		),
+	})
+
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+func (m msgServer) UnfreezeAccount(goCtx context.Context, msg *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.AuthorizedFor(ctx, operator, types.RoleBlocklistAdmin) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Operator)
+	}
+	if !m.Keeper.IsAccountFrozen(ctx, address) {
+		return nil, sdkerrors.Wrap(types.ErrAccountNotFrozen, msg.Address)
+	}
+
+	m.Keeper.DeleteFrozenAccount(ctx, address)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+		sdk.NewEvent(
+			types.EventTypeUnfreezeAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
 		),
 	})
 
-	return &types.MsgDeleteSuperResponse{}, nil
+	return &types.MsgUnfreezeAccountResponse{}, nil
 }
@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// SubmitAction executes the action at once if a single genesis super approval meets the threshold,
+// otherwise stores it pending the approvals of the other genesis supers.
+// It returns the id of the pending action, zero if executed.
+func (k Keeper) SubmitAction(ctx sdk.Context, action types.PendingAction) (uint64, error) {
+	if err := k.validateAction(ctx, action); err != nil {
+		return 0, err
+	}
+
+	if k.GetParams(ctx).GenesisThreshold <= 1 {
+		k.executeAction(ctx, action)
+		return 0, nil
+	}
+
+	action.Id = k.GetNextActionID(ctx)
+	k.SetNextActionID(ctx, action.Id+1)
+	k.SetPendingAction(ctx, action)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposeAction,
+			sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", action.Id)),
+			sdk.NewAttribute(types.AttributeKeyActionType, action.ActionType.String()),
+			sdk.NewAttribute(types.AttributeKeySuperAddress, action.Address),
+			sdk.NewAttribute(types.AttributeKeyProposer, action.Proposer),
+		),
+	)
+	return action.Id, nil
+}
+
+// ApproveAction records the approval of the genesis super and executes the action once the approvals
+// of the current genesis supers meet the threshold. It returns true if the action is executed.
+func (k Keeper) ApproveAction(ctx sdk.Context, id uint64, approver sdk.AccAddress) (bool, error) {
+	if !k.IsGenesisSuper(ctx, approver) {
+		return false, sdkerrors.Wrap(types.ErrUnknownOperator, approver.String())
+	}
+
+	action, found := k.GetPendingAction(ctx, id)
+	if !found {
+		return false, sdkerrors.Wrapf(types.ErrUnknownAction, "%d", id)
+	}
+	if action.Expired(ctx.BlockTime()) {
+		return false, sdkerrors.Wrapf(types.ErrActionExpired, "%d", id)
+	}
+	if action.HasApproved(approver.String()) {
+		return false, sdkerrors.Wrapf(types.ErrAlreadyApproved, "%s has approved %d", approver, id)
+	}
+
+	action.Approvals = append(action.Approvals, approver.String())
+	approvals := k.GetApprovalCount(ctx, action)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApproveAction,
+			sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyApprover, approver.String()),
+			sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d", approvals)),
+		),
+	)
+
+	if approvals < int(k.GetParams(ctx).GenesisThreshold) {
+		k.SetPendingAction(ctx, action)
+		return false, nil
+	}
+
+	// the state may have changed since the action was proposed
+	if err := k.validateAction(ctx, action); err != nil {
+		return false, err
+	}
+	k.DeletePendingAction(ctx, action)
+	k.executeAction(ctx, action)
+	return true, nil
+}
+
+// GetApprovalCount returns the number of the approvals from the current genesis supers,
+// the approvals of the supers removed or changed to ordinary since are not counted
+func (k Keeper) GetApprovalCount(ctx sdk.Context, action types.PendingAction) (count int) {
+	for _, approval := range action.Approvals {
+		approver, err := sdk.AccAddressFromBech32(approval)
+		if err == nil && k.IsGenesisSuper(ctx, approver) {
+			count++
+		}
+	}
+	return count
+}
+
+func (k Keeper) validateAction(ctx sdk.Context, action types.PendingAction) error {
+	address, err := sdk.AccAddressFromBech32(action.Address)
+	if err != nil {
+		return err
+	}
+
+	switch action.ActionType {
+	case types.ActionAddSuper:
+		if _, found := k.GetSuper(ctx, address); found {
+			return sdkerrors.Wrap(types.ErrSuperExists, action.Address)
+		}
+		if k.IsAccountFrozen(ctx, address) {
+			return sdkerrors.Wrap(types.ErrAccountFrozen, action.Address)
+		}
+		super := action.Super()
+		if (super.ExpiryTime != nil || super.ExpiryHeight > 0) && super.Expired(ctx.BlockTime(), ctx.BlockHeight()) {
+			return sdkerrors.Wrap(types.ErrInvalidExpiry, "the super would expire immediately")
+		}
+	case types.ActionDeleteSuper:
+		super, found := k.GetSuper(ctx, address)
+		if !found {
+			return sdkerrors.Wrap(types.ErrUnknownSuper, action.Address)
+		}
+		if super.GetAccountType() == types.Genesis {
+			return sdkerrors.Wrap(types.ErrDeleteGenesisSuper, action.Address)
+		}
+	case types.ActionSetRoles:
+		if _, found := k.GetSuper(ctx, address); !found {
+			return sdkerrors.Wrap(types.ErrUnknownSuper, action.Address)
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrUnknownAction, "invalid action type: %d", action.ActionType)
+	}
+	return nil
+}
+
+func (k Keeper) executeAction(ctx sdk.Context, action types.PendingAction) {
+	switch action.ActionType {
+	case types.ActionAddSuper:
+		k.AddSuper(ctx, action.Super())
+		k.AppendAuditRecord(ctx, types.OperationAddSuper, action.Proposer, action.Address)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAddSuper,
+				sdk.NewAttribute(types.AttributeKeySuperAddress, action.Address),
+				sdk.NewAttribute(types.AttributeKeyAddedBy, action.Proposer),
+				sdk.NewAttribute(types.AttributeKeyRoles, types.RolesString(action.Roles)),
+			),
+		)
+	case types.ActionDeleteSuper:
+		address, _ := sdk.AccAddressFromBech32(action.Address)
+		k.DeleteSuper(ctx, address)
+		k.AppendAuditRecord(ctx, types.OperationDeleteSuper, action.Proposer, action.Address)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeleteSuper,
+				sdk.NewAttribute(types.AttributeKeySuperAddress, action.Address),
+				sdk.NewAttribute(types.AttributeKeyDeletedBy, action.Proposer),
+			),
+		)
+	case types.ActionSetRoles:
+		address, _ := sdk.AccAddressFromBech32(action.Address)
+		super, _ := k.GetSuper(ctx, address)
+		super.Roles = action.Roles
+		k.AddSuper(ctx, super)
+		k.AppendAuditRecord(ctx, types.OperationSetRoles, action.Proposer, action.Address)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetRoles,
+				sdk.NewAttribute(types.AttributeKeySuperAddress, action.Address),
+				sdk.NewAttribute(types.AttributeKeySetBy, action.Proposer),
+				sdk.NewAttribute(types.AttributeKeyRoles, types.RolesString(action.Roles)),
+			),
+		)
+	}
+}
+
+// SetPendingAction stores the pending action and queues it to be dropped at its deadline
+func (k Keeper) SetPendingAction(ctx sdk.Context, action types.PendingAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingActionKey(action.Id), k.cdc.MustMarshalBinaryBare(&action))
+	store.Set(types.GetActionQueueKey(action.Deadline, action.Id), sdk.Uint64ToBigEndian(action.Id))
+}
+
+// DeletePendingAction deletes the pending action and removes it from the queue
+func (k Keeper) DeletePendingAction(ctx sdk.Context, action types.PendingAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingActionKey(action.Id))
+	store.Delete(types.GetActionQueueKey(action.Deadline, action.Id))
+}
+
+// GetPendingAction retrieves the pending action by the specified id
+func (k Keeper) GetPendingAction(ctx sdk.Context, id uint64) (action types.PendingAction, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetPendingActionKey(id)); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &action)
+		return action, true
+	}
+	return action, false
+}
+
+// IteratePendingActions iterates through all pending actions by id
+func (k Keeper) IteratePendingActions(
+	ctx sdk.Context,
+	op func(action types.PendingAction) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingActionKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var action types.PendingAction
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &action)
+
+		if stop := op(action); stop {
+			break
+		}
+	}
+}
+
+// PruneExpiredActions deletes the pending actions past their deadline and returns them
+func (k Keeper) PruneExpiredActions(ctx sdk.Context) (pruned []types.PendingAction) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(
+		types.ActionQueueKey,
+		sdk.PrefixEndBytes(types.GetActionQueuePrefix(ctx.BlockTime())),
+	)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if action, found := k.GetPendingAction(ctx, sdk.BigEndianToUint64(iterator.Value())); found {
+			pruned = append(pruned, action)
+		}
+	}
+
+	for _, action := range pruned {
+		k.DeletePendingAction(ctx, action)
+	}
+	return pruned
+}
+
+// GetNextActionID returns the id of the next pending action
+func (k Keeper) GetNextActionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextActionIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextActionID sets the id of the next pending action
+func (k Keeper) SetNextActionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextActionIDKey, sdk.Uint64ToBigEndian(id))
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func (suite *KeeperTestSuite) setupQuorum(threshold uint32) (sdk.Context, types.MsgServer) {
+	ctx := suite.ctx.WithBlockTime(time.Unix(1600000000, 0).UTC())
+	suite.keeper.SetParams(ctx, types.NewParams(threshold, time.Hour, nil, sdk.OneDec(), 10, types.RoleOracleOperator))
+	suite.keeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, addrs[1], addrs[1]))
+	return ctx, keeper.NewMsgServerImpl(suite.keeper)
+}
+
+func (suite *KeeperTestSuite) TestSubmitActionBelowThreshold() {
+	ctx, msgServer := suite.setupQuorum(1)
+
+	res, err := msgServer.AddSuper(sdk.WrapSDKContext(ctx), types.NewMsgAddSuper("ordinary", addrs[2], addrs[0]))
+	suite.NoError(err)
+	suite.Zero(res.ActionId)
+
+	_, found := suite.keeper.GetSuper(ctx, addrs[2])
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestApproveAddSuperAction() {
+	ctx, msgServer := suite.setupQuorum(2)
+
+	res, err := msgServer.AddSuper(sdk.WrapSDKContext(ctx), types.NewMsgAddSuper("ordinary", addrs[2], addrs[0], types.RoleTokenAdmin))
+	suite.NoError(err)
+	suite.Equal(uint64(1), res.ActionId)
+
+	_, found := suite.keeper.GetSuper(ctx, addrs[2])
+	suite.False(found)
+
+	action, found := suite.keeper.GetPendingAction(ctx, res.ActionId)
+	suite.True(found)
+	suite.Equal(types.ActionAddSuper, action.ActionType)
+	suite.Equal([]string{addrs[0].String()}, action.Approvals)
+	suite.Equal(ctx.BlockTime().Add(time.Hour), action.Deadline)
+
+	// the proposer can't approve twice
+	_, err = msgServer.ApproveAction(sdk.WrapSDKContext(ctx), types.NewMsgApproveAction(res.ActionId, addrs[0]))
+	suite.Error(err)
+
+	// only the genesis supers can approve
+	_, err = msgServer.ApproveAction(sdk.WrapSDKContext(ctx), types.NewMsgApproveAction(res.ActionId, addrs[2]))
+	suite.Error(err)
+
+	approveRes, err := msgServer.ApproveAction(sdk.WrapSDKContext(ctx), types.NewMsgApproveAction(res.ActionId, addrs[1]))
+	suite.NoError(err)
+	suite.True(approveRes.Executed)
+
+	super, found := suite.keeper.GetSuper(ctx, addrs[2])
+	suite.True(found)
+	suite.Equal(addrs[0].String(), super.AddedBy)
+	suite.True(super.HasRole(types.RoleTokenAdmin))
+
+	_, found = suite.keeper.GetPendingAction(ctx, res.ActionId)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestApproveDeleteSuperAction() {
+	ctx, msgServer := suite.setupQuorum(2)
+	suite.keeper.AddSuper(ctx, types.NewSuper("ordinary", types.Ordinary, addrs[2], addrs[0]))
+
+	res, err := msgServer.DeleteSuper(sdk.WrapSDKContext(ctx), types.NewMsgDeleteSuper(addrs[2], addrs[1]))
+	suite.NoError(err)
+	suite.Equal(uint64(1), res.ActionId)
+
+	// the approvals of the former genesis supers are not counted
+	suite.keeper.AddSuper(ctx, types.NewSuper("demoted", types.Ordinary, addrs[1], addrs[1]))
+	approveRes, err := msgServer.ApproveAction(sdk.WrapSDKContext(ctx), types.NewMsgApproveAction(res.ActionId, addrs[0]))
+	suite.NoError(err)
+	suite.False(approveRes.Executed)
+
+	action, _ := suite.keeper.GetPendingAction(ctx, res.ActionId)
+	suite.Len(action.Approvals, 2)
+	suite.Equal(1, suite.keeper.GetApprovalCount(ctx, action))
+
+	_, found := suite.keeper.GetSuper(ctx, addrs[2])
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestApproveSetRolesAction() {
+	ctx, msgServer := suite.setupQuorum(2)
+	suite.keeper.AddSuper(ctx, types.NewSuper("ordinary", types.Ordinary, addrs[2], addrs[0], types.RoleTokenAdmin))
+
+	res, err := msgServer.SetRoles(sdk.WrapSDKContext(ctx), types.NewMsgSetRoles(addrs[2], addrs[0], types.RoleOracleOperator))
+	suite.NoError(err)
+	suite.Equal(uint64(1), res.ActionId)
+
+	// the roles are unchanged until the threshold is met
+	suite.True(suite.keeper.AuthorizedFor(ctx, addrs[2], types.RoleTokenAdmin))
+	suite.False(suite.keeper.AuthorizedFor(ctx, addrs[2], types.RoleOracleOperator))
+
+	approveRes, err := msgServer.ApproveAction(sdk.WrapSDKContext(ctx), types.NewMsgApproveAction(res.ActionId, addrs[1]))
+	suite.NoError(err)
+	suite.True(approveRes.Executed)
+
+	super, _ := suite.keeper.GetSuper(ctx, addrs[2])
+	suite.Equal([]types.Role{types.RoleOracleOperator}, super.Roles)
+
+	var records []types.AuditRecord
+	suite.keeper.IterateAuditLog(ctx, func(record types.AuditRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	suite.Len(records, 1)
+	suite.Equal(types.OperationSetRoles, records[0].Operation)
+	suite.Equal(addrs[0].String(), records[0].Actor)
+	suite.Equal(addrs[2].String(), records[0].Target)
+}
+
+func (suite *KeeperTestSuite) TestSubmitActionByExpiredSuper() {
+	ctx, msgServer := suite.setupQuorum(2)
+	ctx = ctx.WithBlockHeight(10)
+
+	// the expired genesis super neither proposes nor approves
+	expired := types.NewSuper("genesis", types.Genesis, addrs[1], addrs[1]).WithExpiry(nil, 10)
+	suite.keeper.AddSuper(ctx, expired)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := msgServer.AddSuper(goCtx, types.NewMsgAddSuper("ordinary", addrs[2], addrs[1]))
+	suite.Error(err)
+	_, err = msgServer.DeleteSuper(goCtx, types.NewMsgDeleteSuper(addrs[0], addrs[1]))
+	suite.Error(err)
+	_, err = msgServer.SetRoles(goCtx, types.NewMsgSetRoles(addrs[0], addrs[1], types.RoleTokenAdmin))
+	suite.Error(err)
+
+	res, err := msgServer.AddSuper(goCtx, types.NewMsgAddSuper("ordinary", addrs[2], addrs[0]))
+	suite.NoError(err)
+	_, err = msgServer.ApproveAction(goCtx, types.NewMsgApproveAction(res.ActionId, addrs[1]))
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestApproveExpiredAction() {
+	ctx, msgServer := suite.setupQuorum(2)
+
+	res, err := msgServer.AddSuper(sdk.WrapSDKContext(ctx), types.NewMsgAddSuper("ordinary", addrs[2], addrs[0]))
+	suite.NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = msgServer.ApproveAction(sdk.WrapSDKContext(ctx), types.NewMsgApproveAction(res.ActionId, addrs[1]))
+	suite.Error(err)
+
+	pruned := suite.keeper.PruneExpiredActions(ctx)
+	suite.Len(pruned, 1)
+	suite.Equal(res.ActionId, pruned[0].Id)
+
+	_, found := suite.keeper.GetPendingAction(ctx, res.ActionId)
+	suite.False(found)
+	suite.Empty(suite.keeper.PruneExpiredActions(ctx))
+
+	// the ids are not reused
+	res, err = msgServer.AddSuper(sdk.WrapSDKContext(ctx), types.NewMsgAddSuper("ordinary", addrs[2], addrs[0]))
+	suite.NoError(err)
+	suite.Equal(uint64(2), res.ActionId)
+}
+
+func (suite *KeeperTestSuite) TestApproveStaleAction() {
+	ctx, msgServer := suite.setupQuorum(2)
+
+	res, err := msgServer.AddSuper(sdk.WrapSDKContext(ctx), types.NewMsgAddSuper("ordinary", addrs[2], addrs[0]))
+	suite.NoError(err)
+
+	// the super is added by other means in the meantime
+	suite.keeper.AddSuper(ctx, types.NewSuper("ordinary", types.Ordinary, addrs[2], addrs[0]))
+	_, err = msgServer.ApproveAction(sdk.WrapSDKContext(ctx), types.NewMsgApproveAction(res.ActionId, addrs[1]))
+	suite.Error(err)
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// HandleAddSuperProposal is a handler for executing a passed add super proposal
+func HandleAddSuperProposal(ctx sdk.Context, k Keeper, p *types.AddSuperProposal) error {
+	address, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetSuper(ctx, address); found {
+		return sdkerrors.Wrap(types.ErrSuperExists, p.Address)
+	}
+	if k.IsAccountFrozen(ctx, address) {
+		return sdkerrors.Wrap(types.ErrAccountFrozen, p.Address)
+	}
+
+	// the supers added by governance are recorded as added by the gov module account
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	k.AddSuper(ctx, types.NewSuper(p.SuperDescription, p.AccountType, address, govAddr, p.Roles...))
+	k.AppendAuditRecord(ctx, types.OperationAddSuper, govAddr.String(), p.Address)
+
+	k.Logger(ctx).Info("Super added by governance", "address", p.Address, "account_type", p.AccountType.String())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddSuper,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, p.Address),
+			sdk.NewAttribute(types.AttributeKeyAddedBy, govAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAccountType, p.AccountType.String()),
+			sdk.NewAttribute(types.AttributeKeyRoles, types.RolesString(p.Roles)),
+		),
+	)
+	return nil
+}
+
+// HandleDeleteSuperProposal is a handler for executing a passed delete super proposal
+func HandleDeleteSuperProposal(ctx sdk.Context, k Keeper, p *types.DeleteSuperProposal) error {
+	address, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		return err
+	}
+	super, found := k.GetSuper(ctx, address)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownSuper, p.Address)
+	}
+	if super.AccountType == types.Genesis {
+		if err := k.validateGenesisSuperRemoval(ctx, p.Address); err != nil {
+			return err
+		}
+	}
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	k.DeleteSuper(ctx, address)
+	k.AppendAuditRecord(ctx, types.OperationDeleteSuper, govAddr.String(), p.Address)
+
+	k.Logger(ctx).Info("Super deleted by governance", "address", p.Address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteSuper,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, p.Address),
+			sdk.NewAttribute(types.AttributeKeyDeletedBy, govAddr.String()),
+		),
+	)
+	return nil
+}
+
+// HandleChangeSuperTypeProposal is a handler for executing a passed change super type proposal
+func HandleChangeSuperTypeProposal(ctx sdk.Context, k Keeper, p *types.ChangeSuperTypeProposal) error {
+	address, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		return err
+	}
+	super, found := k.GetSuper(ctx, address)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownSuper, p.Address)
+	}
+	if super.AccountType == types.Genesis && p.AccountType != types.Genesis {
+		if err := k.validateGenesisSuperRemoval(ctx, p.Address); err != nil {
+			return err
+		}
+	}
+
+	super.AccountType = p.AccountType
+	if super.AccountType == types.Genesis {
+		// the genesis supers never expire
+		super = super.WithExpiry(nil, 0)
+	}
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	k.AddSuper(ctx, super)
+	k.AppendAuditRecord(ctx, types.OperationChangeSuperType, govAddr.String(), p.Address)
+
+	k.Logger(ctx).Info("Super type changed by governance", "address", p.Address, "account_type", p.AccountType.String())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeSuperType,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, p.Address),
+			sdk.NewAttribute(types.AttributeKeyAccountType, p.AccountType.String()),
+		),
+	)
+	return nil
+}
+
+// validateGenesisSuperRemoval checks that the remaining genesis supers can still meet the threshold
+func (k Keeper) validateGenesisSuperRemoval(ctx sdk.Context, address string) error {
+	count := k.GetGenesisSuperCount(ctx)
+	if count <= 1 {
+		return sdkerrors.Wrap(types.ErrLastGenesisSuper, address)
+	}
+	if threshold := k.GetParams(ctx).GenesisThreshold; count-1 < int(threshold) {
+		return sdkerrors.Wrapf(types.ErrGenesisThreshold, "removing %s leaves %d genesis supers below the threshold %d", address, count-1, threshold)
+	}
+	return nil
+}
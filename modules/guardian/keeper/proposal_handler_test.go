@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func (suite *KeeperTestSuite) TestHandleAddSuperProposal() {
+	err := keeper.HandleAddSuperProposal(suite.ctx, suite.keeper, types.NewAddSuperProposal("title", "description", addrs[0], types.Genesis, "genesis"))
+	suite.Require().NoError(err)
+
+	super, found := suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.Require().True(found)
+	suite.Equal(types.Genesis, super.AccountType)
+	suite.Equal(authtypes.NewModuleAddress(govtypes.ModuleName).String(), super.AddedBy)
+
+	err = keeper.HandleAddSuperProposal(suite.ctx, suite.keeper, types.NewAddSuperProposal("title", "description", addrs[1], types.Ordinary, "ordinary", types.RoleTokenAdmin))
+	suite.Require().NoError(err)
+	suite.True(suite.keeper.AuthorizedFor(suite.ctx, addrs[1], types.RoleTokenAdmin))
+
+	// the super already exists
+	err = keeper.HandleAddSuperProposal(suite.ctx, suite.keeper, types.NewAddSuperProposal("title", "description", addrs[1], types.Genesis, "ordinary"))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestHandleDeleteSuperProposal() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[1], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[2], addrs[0]))
+
+	err := keeper.HandleDeleteSuperProposal(suite.ctx, suite.keeper, types.NewDeleteSuperProposal("title", "description", addrs[2]))
+	suite.Require().NoError(err)
+
+	// a genesis super can be deleted by governance
+	err = keeper.HandleDeleteSuperProposal(suite.ctx, suite.keeper, types.NewDeleteSuperProposal("title", "description", addrs[1]))
+	suite.Require().NoError(err)
+	suite.Equal(1, suite.keeper.GetGenesisSuperCount(suite.ctx))
+
+	// but not the last one
+	err = keeper.HandleDeleteSuperProposal(suite.ctx, suite.keeper, types.NewDeleteSuperProposal("title", "description", addrs[0]))
+	suite.Require().Error(err)
+	suite.True(types.ErrLastGenesisSuper.Is(err))
+	_, found := suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.True(found)
+
+	err = keeper.HandleDeleteSuperProposal(suite.ctx, suite.keeper, types.NewDeleteSuperProposal("title", "description", addrs[2]))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestHandleDeleteSuperProposalThreshold() {
+	suite.keeper.SetParams(suite.ctx, types.NewParams(2, time.Hour, nil, sdk.OneDec(), 10, types.RoleOracleOperator))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[1], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[2], addrs[0]))
+
+	err := keeper.HandleDeleteSuperProposal(suite.ctx, suite.keeper, types.NewDeleteSuperProposal("title", "description", addrs[2]))
+	suite.Require().NoError(err)
+
+	// the remaining genesis supers would be below the threshold
+	err = keeper.HandleDeleteSuperProposal(suite.ctx, suite.keeper, types.NewDeleteSuperProposal("title", "description", addrs[1]))
+	suite.Require().Error(err)
+	suite.True(types.ErrGenesisThreshold.Is(err))
+
+	err = keeper.HandleChangeSuperTypeProposal(suite.ctx, suite.keeper, types.NewChangeSuperTypeProposal("title", "description", addrs[1], types.Ordinary))
+	suite.Require().Error(err)
+	suite.True(types.ErrGenesisThreshold.Is(err))
+	suite.Equal(2, suite.keeper.GetGenesisSuperCount(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestHandleChangeSuperTypeProposal() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0]))
+
+	// the last genesis super can't be changed to ordinary
+	err := keeper.HandleChangeSuperTypeProposal(suite.ctx, suite.keeper, types.NewChangeSuperTypeProposal("title", "description", addrs[0], types.Ordinary))
+	suite.Require().Error(err)
+	suite.True(types.ErrLastGenesisSuper.Is(err))
+
+	err = keeper.HandleChangeSuperTypeProposal(suite.ctx, suite.keeper, types.NewChangeSuperTypeProposal("title", "description", addrs[1], types.Genesis))
+	suite.Require().NoError(err)
+	suite.Equal(2, suite.keeper.GetGenesisSuperCount(suite.ctx))
+
+	err = keeper.HandleChangeSuperTypeProposal(suite.ctx, suite.keeper, types.NewChangeSuperTypeProposal("title", "description", addrs[0], types.Ordinary))
+	suite.Require().NoError(err)
+
+	super, found := suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.Require().True(found)
+	suite.Equal(types.Ordinary, super.AccountType)
+
+	err = keeper.HandleChangeSuperTypeProposal(suite.ctx, suite.keeper, types.NewChangeSuperTypeProposal("title", "description", addrs[2], types.Genesis))
+	suite.Require().Error(err)
+
+	// the changes are audited as made by the gov module account, the failed ones aren't
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	records := suite.getAuditLog(suite.ctx)
+	suite.Require().Len(records, 2)
+	for i, target := range []sdk.AccAddress{addrs[1], addrs[0]} {
+		suite.Equal(types.OperationChangeSuperType, records[i].Operation)
+		suite.Equal(govAddr, records[i].Actor)
+		suite.Equal(target.String(), records[i].Target)
+	}
+}
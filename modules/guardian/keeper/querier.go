@@ -3,6 +3,7 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,29 +13,239 @@ import (
 
 // NewQuerier creates a querier for guardian REST endpoints
 func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
-	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		switch path[0] {
 		case types.QuerySupers:
-			return querySupers(ctx, k, legacyQuerierCdc)
+			return querySupers(ctx, req, k, legacyQuerierCdc)
+		case types.QuerySuper:
+			return querySuper(ctx, req, k, legacyQuerierCdc)
+		case types.QueryPendingActions:
+			return queryPendingActions(ctx, req, k, legacyQuerierCdc)
+		case types.QueryPendingAction:
+			return queryPendingAction(ctx, req, k, legacyQuerierCdc)
+		case types.QueryAuditLog:
+			return queryAuditLog(ctx, req, k, legacyQuerierCdc)
+		case types.QueryDisabledMsgTypes:
+			return queryDisabledMsgTypes(ctx, k, legacyQuerierCdc)
+		case types.QueryFrozenAccounts:
+			return queryFrozenAccounts(ctx, req, k, legacyQuerierCdc)
+		case types.QueryFrozenAccount:
+			return queryFrozenAccount(ctx, req, k, legacyQuerierCdc)
+		case types.QueryParams:
+			return queryParams(ctx, k, legacyQuerierCdc)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
 	}
 }
 
-func querySupers(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
-	var supers []types.Super
+func querySupers(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QuerySupersParams
+	if len(req.Data) > 0 {
+		if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	filter, err := types.NewSuperFilter(params.Role, params.AccountType, params.AddedBy)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	supers := []types.Super{}
 	k.IterateSupers(
 		ctx,
 		func(super types.Super) bool {
-			supers = append(supers, super)
+			if filter.Match(super) {
+				supers = append(supers, super)
+			}
 			return false
 		},
 	)
 
+	if params.Limit > 0 {
+		start, end := client.Paginate(len(supers), params.Page, params.Limit, len(supers))
+		if start < 0 || end < 0 {
+			supers = []types.Super{}
+		} else {
+			supers = supers[start:end]
+		}
+	}
+
 	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, supers)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
 	return bz, nil
 }
+
+func querySuper(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QuerySuperParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	super, found := k.GetSuper(ctx, params.Address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownSuper, params.Address.String())
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, super)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPendingActions(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryPendingActionsParams
+	if len(req.Data) > 0 {
+		if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	actions := []types.PendingAction{}
+	k.IteratePendingActions(
+		ctx,
+		func(action types.PendingAction) bool {
+			actions = append(actions, action)
+			return false
+		},
+	)
+
+	if params.Limit > 0 {
+		start, end := client.Paginate(len(actions), params.Page, params.Limit, len(actions))
+		if start < 0 || end < 0 {
+			actions = []types.PendingAction{}
+		} else {
+			actions = actions[start:end]
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, actions)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPendingAction(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryPendingActionParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	action, found := k.GetPendingAction(ctx, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownAction, "%d", params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, action)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryAuditLog(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryAuditLogParams
+	if len(req.Data) > 0 {
+		if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	records := []types.AuditRecord{}
+	k.IterateAuditLog(
+		ctx,
+		func(record types.AuditRecord) bool {
+			if len(params.Target) == 0 || record.Target == params.Target {
+				records = append(records, record)
+			}
+			return false
+		},
+	)
+
+	if params.Limit > 0 {
+		start, end := client.Paginate(len(records), params.Page, params.Limit, len(records))
+		if start < 0 || end < 0 {
+			records = []types.AuditRecord{}
+		} else {
+			records = records[start:end]
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryDisabledMsgTypes(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, k.GetDisabledMsgTypes(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryFrozenAccounts(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryFrozenAccountsParams
+	if len(req.Data) > 0 {
+		if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	accounts := []types.FrozenAccount{}
+	k.IterateFrozenAccounts(
+		ctx,
+		func(account types.FrozenAccount) bool {
+			accounts = append(accounts, account)
+			return false
+		},
+	)
+
+	if params.Limit > 0 {
+		start, end := client.Paginate(len(accounts), params.Page, params.Limit, len(accounts))
+		if start < 0 || end < 0 {
+			accounts = []types.FrozenAccount{}
+		} else {
+			accounts = accounts[start:end]
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, accounts)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryFrozenAccount(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryFrozenAccountParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	account, found := k.GetFrozenAccount(ctx, params.Address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrAccountNotFrozen, params.Address.String())
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, k.GetParams(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -36,4 +36,45 @@ func (suite *KeeperTestSuite) TestNewQuerier() {
 		equal := val.Equal(types.DefaultGenesisState().Supers[i])
 		suite.True(equal)
 	}
+
+	genesis := types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0])
+	ordinary := types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0])
+	suite.keeper.AddSuper(suite.ctx, genesis)
+	suite.keeper.AddSuper(suite.ctx, ordinary)
+
+	// test querySupers with filters and pagination
+	bz, e := suite.cdc.MarshalJSON(types.QuerySupersParams{AccountType: "Ordinary", AddedBy: addrs[0].String()})
+	suite.NoError(e)
+	res, err = querier(suite.ctx, []string{types.QuerySupers}, abci.RequestQuery{Data: bz})
+	suite.NoError(err)
+	supers = nil
+	suite.NoError(suite.cdc.UnmarshalJSON(res, &supers))
+	suite.Equal([]types.Super{ordinary}, supers)
+
+	bz, e = suite.cdc.MarshalJSON(types.QuerySupersParams{Page: 2, Limit: 1})
+	suite.NoError(e)
+	res, err = querier(suite.ctx, []string{types.QuerySupers}, abci.RequestQuery{Data: bz})
+	suite.NoError(err)
+	supers = nil
+	suite.NoError(suite.cdc.UnmarshalJSON(res, &supers))
+	suite.Len(supers, 1)
+
+	bz, e = suite.cdc.MarshalJSON(types.QuerySupersParams{AccountType: "Admin"})
+	suite.NoError(e)
+	_, err = querier(suite.ctx, []string{types.QuerySupers}, abci.RequestQuery{Data: bz})
+	suite.Error(err)
+
+	// test querySuper
+	bz, e = suite.cdc.MarshalJSON(types.QuerySuperParams{Address: addrs[1]})
+	suite.NoError(e)
+	res, err = querier(suite.ctx, []string{types.QuerySuper}, abci.RequestQuery{Data: bz})
+	suite.NoError(err)
+	var super types.Super
+	suite.NoError(suite.cdc.UnmarshalJSON(res, &super))
+	suite.Equal(ordinary, super)
+
+	bz, e = suite.cdc.MarshalJSON(types.QuerySuperParams{Address: addrs[2]})
+	suite.NoError(e)
+	_, err = querier(suite.ctx, []string{types.QuerySuper}, abci.RequestQuery{Data: bz})
+	suite.Error(err)
 }
@@ -0,0 +1,56 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// NewDecodeStore returns a function closure that unmarshals the KVPair's values
+// to the corresponding types.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.SuperKey):
+			var superA, superB types.Super
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &superA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &superB)
+			return fmt.Sprintf("%v\n%v", superA, superB)
+		case bytes.Equal(kvA.Key[:1], types.ExpiryHeightQueueKey),
+			bytes.Equal(kvA.Key[:1], types.ExpiryTimeQueueKey):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.PendingActionKey):
+			var actionA, actionB types.PendingAction
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &actionA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &actionB)
+			return fmt.Sprintf("%v\n%v", actionA, actionB)
+		case bytes.Equal(kvA.Key[:1], types.ActionQueueKey),
+			bytes.Equal(kvA.Key, types.NextActionIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.AuditLogKey):
+			var recordA, recordB types.AuditRecord
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.DisabledMsgTypeKey):
+			return fmt.Sprintf("%s\n%s", kvA.Key[1:], kvB.Key[1:])
+		case bytes.Equal(kvA.Key[:1], types.FrozenAccountKey):
+			var accountA, accountB types.FrozenAccount
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &accountA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &accountB)
+			return fmt.Sprintf("%v\n%v", accountA, accountB)
+		case bytes.Equal(kvA.Key[:1], types.FeeExemptionKey):
+			return fmt.Sprintf("%d:%d\n%d:%d",
+				sdk.BigEndianToUint64(kvA.Value[:8]), sdk.BigEndianToUint64(kvA.Value[8:]),
+				sdk.BigEndianToUint64(kvB.Value[:8]), sdk.BigEndianToUint64(kvB.Value[8:]),
+			)
+		default:
+			panic(fmt.Sprintf("invalid guardian key %X", kvA.Key))
+		}
+	}
+}
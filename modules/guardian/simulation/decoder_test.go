@@ -0,0 +1,77 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/irisnet/irishub/modules/guardian/simulation"
+	"github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	genesisAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	superAddr   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func TestDecodeStore(t *testing.T) {
+	now := time.Now().UTC()
+	super := types.NewSuper("test", types.Ordinary, superAddr, genesisAddr, types.RoleTokenAdmin).
+		WithExpiry(nil, 100)
+	action := types.NewAddSuperAction(super, now)
+	action.Id = 1
+	frozen := types.NewFrozenAccount(superAddr, genesisAddr, "stolen key", 10)
+	record := types.NewAuditRecord(1, types.OperationAddSuper, genesisAddr.String(), superAddr.String(), 10, now)
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetSuperKey(superAddr), Value: cdc.MustMarshalBinaryBare(&super)},
+			{Key: types.GetExpiryHeightQueueKey(100, superAddr), Value: superAddr},
+			{Key: types.GetPendingActionKey(action.Id), Value: cdc.MustMarshalBinaryBare(&action)},
+			{Key: types.GetActionQueueKey(now, action.Id), Value: sdk.Uint64ToBigEndian(action.Id)},
+			{Key: types.NextActionIDKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.GetAuditRecordKey(record.Id), Value: cdc.MustMarshalBinaryBare(&record)},
+			{Key: types.GetDisabledMsgTypeKey("/irismod.token.MsgMintToken"), Value: []byte{0x01}},
+			{Key: types.GetFrozenAccountKey(superAddr), Value: cdc.MustMarshalBinaryBare(&frozen)},
+			{Key: types.GetFeeExemptionKey(superAddr), Value: append(sdk.Uint64ToBigEndian(10), sdk.Uint64ToBigEndian(3)...)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Super", fmt.Sprintf("%v\n%v", super, super)},
+		{"ExpiryQueue", fmt.Sprintf("%v\n%v", superAddr, superAddr)},
+		{"PendingAction", fmt.Sprintf("%v\n%v", action, action)},
+		{"ActionQueue", "1\n1"},
+		{"NextActionID", "2\n2"},
+		{"AuditRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"DisabledMsgType", "/irismod.token.MsgMintToken\n/irismod.token.MsgMintToken"},
+		{"FrozenAccount", fmt.Sprintf("%v\n%v", frozen, frozen)},
+		{"FeeExemption", "10:3\n10:3"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// Simulation parameter constants
+const (
+	Supers = "supers"
+)
+
+// GenRoles randomized Roles, without duplicates
+func GenRoles(r *rand.Rand) []types.Role {
+	var roles []types.Role
+	for _, i := range r.Perm(len(types.Role_name) - 1)[:r.Intn(len(types.Role_name))] {
+		roles = append(roles, types.Role(i+1))
+	}
+	return roles
+}
+
+// GenExpiry randomized expiry: no expiry, an expiry height or an expiry time after the genesis time
+func GenExpiry(r *rand.Rand, genesisTime time.Time) (*time.Time, int64) {
+	switch r.Intn(3) {
+	case 0:
+		return nil, 0
+	case 1:
+		return nil, int64(simtypes.RandIntBetween(r, 2, 500))
+	default:
+		expiryTime := genesisTime.Add(time.Duration(simtypes.RandIntBetween(r, 1, 240)) * time.Minute)
+		return &expiryTime, 0
+	}
+}
+
+// GenSupers randomized Supers: a few genesis supers, each of which may have added some ordinary
+// supers with random roles, some of which expire
+func GenSupers(r *rand.Rand, accs []simtypes.Account, genesisTime time.Time) []types.Super {
+	if len(accs) == 0 {
+		return nil
+	}
+
+	perm := r.Perm(len(accs))
+	genesisCount := simtypes.RandIntBetween(r, 1, 4)
+	if genesisCount > len(perm) {
+		genesisCount = len(perm)
+	}
+
+	var supers []types.Super
+	for _, i := range perm[:genesisCount] {
+		supers = append(supers, types.NewSuper(
+			simtypes.RandStringOfLength(r, 10), types.Genesis, accs[i].Address, accs[i].Address,
+		))
+	}
+	for _, i := range perm[genesisCount:] {
+		if r.Intn(4) != 0 {
+			continue
+		}
+		addedBy := accs[perm[r.Intn(genesisCount)]].Address
+		super := types.NewSuper(
+			simtypes.RandStringOfLength(r, 10), types.Ordinary, accs[i].Address, addedBy, GenRoles(r)...,
+		)
+		supers = append(supers, super.WithExpiry(GenExpiry(r, genesisTime)))
+	}
+	return supers
+}
+
+// RandomizedGenState generates a random GenesisState for guardian
+func RandomizedGenState(simState *module.SimulationState) {
+	var supers []types.Super
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Supers, &supers, simState.Rand,
+		func(r *rand.Rand) { supers = GenSupers(r, simState.Accounts, simState.GenTimestamp) },
+	)
+
+	guardianGenesis := types.NewGenesisState(supers, types.DefaultParams(), nil, 1, nil, nil, nil)
+
+	bz, err := json.MarshalIndent(&guardianGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(guardianGenesis)
+}
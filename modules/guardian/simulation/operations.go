@@ -0,0 +1,191 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgAddSuper    = "op_weight_msg_add_super"
+	OpWeightMsgDeleteSuper = "op_weight_msg_delete_super"
+
+	// DefaultWeightMsgAddSuper default weight of the add super message, also listed in the app params
+	DefaultWeightMsgAddSuper = 20
+	// DefaultWeightMsgDeleteSuper default weight of the delete super message, also listed in the app params
+	DefaultWeightMsgDeleteSuper = 10
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONMarshaler,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgAddSuper int
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgAddSuper, &weightMsgAddSuper, nil,
+		func(_ *rand.Rand) { weightMsgAddSuper = DefaultWeightMsgAddSuper },
+	)
+
+	var weightMsgDeleteSuper int
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgDeleteSuper, &weightMsgDeleteSuper, nil,
+		func(_ *rand.Rand) { weightMsgDeleteSuper = DefaultWeightMsgDeleteSuper },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgAddSuper,
+			SimulateMsgAddSuper(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDeleteSuper,
+			SimulateMsgDeleteSuper(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgAddSuper generates a MsgAddSuper with random values, signed by a random genesis super.
+// The msgs signed by the other accounts or adding an existing super are delivered as well and must fail.
+func SimulateMsgAddSuper(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		operator, expectPass := randomOperator(r, ctx, k, accs)
+
+		target, _ := simtypes.RandomAcc(r, accs)
+		if _, found := k.GetSuper(ctx, target.Address); found {
+			expectPass = false
+		}
+
+		msg := types.NewMsgAddSuper(
+			simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, types.MaxDescriptionLength)),
+			target.Address, operator.Address, GenRoles(r)...,
+		)
+		if r.Intn(2) == 0 {
+			msg = msg.WithExpiry(nil, ctx.BlockHeight()+int64(simtypes.RandIntBetween(r, 1, 100)))
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, chainID, expectPass)
+	}
+}
+
+// SimulateMsgDeleteSuper generates a MsgDeleteSuper deleting a random ordinary super, signed by a random genesis super.
+// The msgs signed by the other accounts or deleting a genesis super are delivered as well and must fail.
+func SimulateMsgDeleteSuper(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		operator, expectPass := randomOperator(r, ctx, k, accs)
+
+		var genesis, ordinary []sdk.AccAddress
+		k.IterateSupers(ctx, func(super types.Super) bool {
+			address, _ := sdk.AccAddressFromBech32(super.Address)
+			if super.AccountType == types.Genesis {
+				genesis = append(genesis, address)
+			} else {
+				ordinary = append(ordinary, address)
+			}
+			return false
+		})
+
+		var target sdk.AccAddress
+		switch {
+		case len(ordinary) > 0 && (len(genesis) == 0 || r.Intn(10) > 0):
+			target = ordinary[r.Intn(len(ordinary))]
+		case len(genesis) > 0:
+			// the genesis supers can't be deleted
+			target = genesis[r.Intn(len(genesis))]
+			expectPass = false
+		default:
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteSuper, "no super to delete"), nil, nil
+		}
+
+		msg := types.NewMsgDeleteSuper(target, operator.Address)
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, chainID, expectPass)
+	}
+}
+
+// randomOperator returns a random genesis super, or once in a while a random account which isn't a
+// genesis super. It returns false if the account isn't allowed to operate.
+func randomOperator(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (simtypes.Account, bool) {
+	var genesisSupers, others []simtypes.Account
+	for _, acc := range accs {
+		if k.IsGenesisSuper(ctx, acc.Address) {
+			genesisSupers = append(genesisSupers, acc)
+		} else {
+			others = append(others, acc)
+		}
+	}
+	if len(genesisSupers) > 0 && (len(others) == 0 || r.Intn(10) > 0) {
+		return genesisSupers[r.Intn(len(genesisSupers))], true
+	}
+	return others[r.Intn(len(others))], false
+}
+
+// deliver signs the msg by the operator with random fees and delivers it,
+// it returns an error if the delivery result doesn't match the expectation
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	ak types.AccountKeeper, bk types.BankKeeper,
+	operator simtypes.Account, msg sdk.Msg, chainID string, expectPass bool,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, operator.Address)
+	if account == nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "operator account not found"), nil, nil
+	}
+	spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+	fees, err := simtypes.RandomFees(r, ctx, spendable)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		operator.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if !expectPass {
+		if err == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "invalid tx delivered"), nil,
+				fmt.Errorf("%s signed by %s is expected to fail", msg.Type(), operator.Address)
+		}
+		return simtypes.NewOperationMsg(msg, false, err.Error()), nil, nil
+	}
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
@@ -0,0 +1,75 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/irisnet/irishub/modules/guardian/simulation"
+	"github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+func setupOperations(t *testing.T, numAccounts int) (*simapp.SimApp, sdk.Context, []simtypes.Account) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(1)), numAccounts)
+	for _, account := range accounts {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, account.Address))
+		require.NoError(t, app.BankKeeper.AddCoins(ctx, account.Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))))
+	}
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+	return app, ctx, accounts
+}
+
+func TestSimulateMsgAddSuper(t *testing.T) {
+	app, ctx, accounts := setupOperations(t, 1)
+	op := simulation.SimulateMsgAddSuper(app.AccountKeeper, app.BankKeeper, app.GuardianKeeper)
+
+	// signed by an account which isn't a genesis super
+	operationMsg, _, err := op(rand.New(rand.NewSource(1)), app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgAddSuper, operationMsg.Name)
+
+	// adding an existing super
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, accounts[0].Address, accounts[0].Address))
+	operationMsg, _, err = op(rand.New(rand.NewSource(1)), app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+}
+
+func TestSimulateMsgDeleteSuper(t *testing.T) {
+	app, ctx, accounts := setupOperations(t, 1)
+	op := simulation.SimulateMsgDeleteSuper(app.AccountKeeper, app.BankKeeper, app.GuardianKeeper)
+
+	operationMsg, _, err := op(rand.New(rand.NewSource(1)), app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+	require.Equal(t, "no super to delete", operationMsg.Comment)
+
+	// deleting a genesis super
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, accounts[0].Address, accounts[0].Address))
+	operationMsg, _, err = op(rand.New(rand.NewSource(1)), app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgDeleteSuper, operationMsg.Name)
+
+	// deleting an ordinary super
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("ordinary", types.Ordinary, sdk.AccAddress("ordinary_super______"), accounts[0].Address))
+	operationMsg, _, err = op(rand.New(rand.NewSource(1)), app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	_, found := app.GuardianKeeper.GetSuper(ctx, sdk.AccAddress("ordinary_super______"))
+	require.False(t, found)
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewAddSuperAction returns the pending action to add the super, approved by the proposer who adds it
+func NewAddSuperAction(super Super, deadline time.Time) PendingAction {
+	return PendingAction{
+		ActionType:   ActionAddSuper,
+		Address:      super.Address,
+		Description:  super.Description,
+		Roles:        super.Roles,
+		ExpiryTime:   super.ExpiryTime,
+		ExpiryHeight: super.ExpiryHeight,
+		Proposer:     super.AddedBy,
+		Approvals:    []string{super.AddedBy},
+		Deadline:     deadline,
+	}
+}
+
+// NewDeleteSuperAction returns the pending action to delete the super, approved by the proposer
+func NewDeleteSuperAction(address, proposer sdk.AccAddress, deadline time.Time) PendingAction {
+	return PendingAction{
+		ActionType: ActionDeleteSuper,
+		Address:    address.String(),
+		Proposer:   proposer.String(),
+		Approvals:  []string{proposer.String()},
+		Deadline:   deadline,
+	}
+}
+
+// NewSetRolesAction returns the pending action to set the roles of the super, approved by the proposer
+func NewSetRolesAction(address, proposer sdk.AccAddress, roles []Role, deadline time.Time) PendingAction {
+	return PendingAction{
+		ActionType: ActionSetRoles,
+		Address:    address.String(),
+		Roles:      roles,
+		Proposer:   proposer.String(),
+		Approvals:  []string{proposer.String()},
+		Deadline:   deadline,
+	}
+}
+
+// Super returns the ordinary super added by the action
+func (a PendingAction) Super() Super {
+	return Super{
+		Description:  a.Description,
+		AccountType:  Ordinary,
+		Address:      a.Address,
+		AddedBy:      a.Proposer,
+		Roles:        a.Roles,
+		ExpiryTime:   a.ExpiryTime,
+		ExpiryHeight: a.ExpiryHeight,
+	}
+}
+
+// HasApproved returns true if the address has approved the action
+func (a PendingAction) HasApproved(address string) bool {
+	for _, approval := range a.Approvals {
+		if approval == address {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired returns true if the action is past its deadline at the block time
+func (a PendingAction) Expired(blockTime time.Time) bool {
+	return !blockTime.Before(a.Deadline)
+}
+
+// Validate checks the stateless fields of the pending action
+func (a PendingAction) Validate() error {
+	if a.Id == 0 {
+		return sdkerrors.Wrap(ErrUnknownAction, "action id missing")
+	}
+	if a.ActionType != ActionAddSuper && a.ActionType != ActionDeleteSuper && a.ActionType != ActionSetRoles {
+		return sdkerrors.Wrapf(ErrUnknownAction, "invalid action type: %d", a.ActionType)
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Proposer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	seen := make(map[string]bool)
+	for _, approval := range a.Approvals {
+		if _, err := sdk.AccAddressFromBech32(approval); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+		if seen[approval] {
+			return sdkerrors.Wrapf(ErrAlreadyApproved, "duplicated approval: %s", approval)
+		}
+		seen[approval] = true
+	}
+	if err := ValidateRoles(a.Roles); err != nil {
+		return err
+	}
+	return ValidateExpiry(a.ExpiryTime, a.ExpiryHeight)
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewAuditRecord constructs an audit record
+func NewAuditRecord(id uint64, operation AuditOperation, actor, target string, height int64, time time.Time) AuditRecord {
+	return AuditRecord{
+		Id:        id,
+		Operation: operation,
+		Actor:     actor,
+		Target:    target,
+		Height:    height,
+		Time:      time,
+	}
+}
+
+// Validate checks the stateless fields of the audit record
+func (r AuditRecord) Validate() error {
+	if r.Id == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "audit record id missing")
+	}
+	if _, ok := AuditOperation_name[int32(r.Operation)]; !ok || r.Operation == OperationUnspecified {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid audit operation: %d", r.Operation)
+	}
+	if len(r.Actor) > 0 {
+		if _, err := sdk.AccAddressFromBech32(r.Actor); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Target); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if r.Height < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "audit record height (%d) must not be negative", r.Height)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewFrozenAccount constructs a frozen account
+func NewFrozenAccount(address, frozenBy sdk.AccAddress, reason string, height int64) FrozenAccount {
+	return FrozenAccount{
+		Address:  address.String(),
+		FrozenBy: frozenBy.String(),
+		Reason:   reason,
+		Height:   height,
+	}
+}
+
+// Validate checks the stateless fields of the frozen account
+func (a FrozenAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(a.FrozenBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "frozen by: %s", err)
+	}
+	if len(a.Reason) > MaxReasonLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid reason length; got: %d, max: %d", len(a.Reason), MaxReasonLength)
+	}
+	if a.Height < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "frozen account height (%d) must not be negative", a.Height)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"regexp"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxMsgTypeURLLength is the max length of a msg type url
+const MaxMsgTypeURLLength = 256
+
+var reMsgTypeURL = regexp.MustCompile(`^/[a-zA-Z0-9_.]+$`)
+
+// MsgTypeURL returns the type url of the msg, e.g. "/irishub.guardian.MsgAddSuper"
+func MsgTypeURL(msg proto.Message) string {
+	return "/" + proto.MessageName(msg)
+}
+
+// IsCircuitBreakerMsgType returns true if the type url is of the msgs operating the circuit breaker,
+// which can't be disabled so that the disabled msg types can always be re-enabled
+func IsCircuitBreakerMsgType(msgTypeURL string) bool {
+	return msgTypeURL == MsgTypeURL(&MsgDisableMsgTypes{}) || msgTypeURL == MsgTypeURL(&MsgEnableMsgTypes{})
+}
+
+// ValidateMsgTypeURL checks that the msg type url is well formed and may be disabled
+func ValidateMsgTypeURL(msgTypeURL string) error {
+	if err := validateMsgTypeURLFormat(msgTypeURL); err != nil {
+		return err
+	}
+	if IsCircuitBreakerMsgType(msgTypeURL) {
+		return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "%s can't be disabled", msgTypeURL)
+	}
+	return nil
+}
+
+// ValidateMsgTypeURLs checks that the msg type urls are valid and not duplicated
+func ValidateMsgTypeURLs(msgTypeURLs []string) error {
+	seen := make(map[string]bool)
+	for _, msgTypeURL := range msgTypeURLs {
+		if err := ValidateMsgTypeURL(msgTypeURL); err != nil {
+			return err
+		}
+		if seen[msgTypeURL] {
+			return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "duplicated msg type url %s", msgTypeURL)
+		}
+		seen[msgTypeURL] = true
+	}
+	return nil
+}
+
+func validateMsgTypeURLFormat(msgTypeURL string) error {
+	if len(msgTypeURL) > MaxMsgTypeURLLength {
+		return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "length of %s exceeds %d", msgTypeURL, MaxMsgTypeURLLength)
+	}
+	if !reMsgTypeURL.MatchString(msgTypeURL) {
+		return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "%s, expected e.g. /irishub.guardian.MsgAddSuper", msgTypeURL)
+	}
+	return nil
+}
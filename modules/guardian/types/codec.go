@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary module/guardian interfaces and concrete types
@@ -13,12 +14,34 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddSuper{}, "irishub/guardian/MsgAddSuper", nil)
 	cdc.RegisterConcrete(&MsgDeleteSuper{}, "irishub/guardian/MsgDeleteSuper", nil)
+	cdc.RegisterConcrete(&MsgSetRoles{}, "irishub/guardian/MsgSetRoles", nil)
+	cdc.RegisterConcrete(&MsgApproveAction{}, "irishub/guardian/MsgApproveAction", nil)
+	cdc.RegisterConcrete(&MsgUpdateSuper{}, "irishub/guardian/MsgUpdateSuper", nil)
+	cdc.RegisterConcrete(&MsgDisableMsgTypes{}, "irishub/guardian/MsgDisableMsgTypes", nil)
+	cdc.RegisterConcrete(&MsgEnableMsgTypes{}, "irishub/guardian/MsgEnableMsgTypes", nil)
+	cdc.RegisterConcrete(&MsgFreezeAccount{}, "irishub/guardian/MsgFreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAccount{}, "irishub/guardian/MsgUnfreezeAccount", nil)
+	cdc.RegisterConcrete(&AddSuperProposal{}, "irishub/guardian/AddSuperProposal", nil)
+	cdc.RegisterConcrete(&DeleteSuperProposal{}, "irishub/guardian/DeleteSuperProposal", nil)
+	cdc.RegisterConcrete(&ChangeSuperTypeProposal{}, "irishub/guardian/ChangeSuperTypeProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddSuper{},
 		&MsgDeleteSuper{},
+		&MsgSetRoles{},
+		&MsgApproveAction{},
+		&MsgUpdateSuper{},
+		&MsgDisableMsgTypes{},
+		&MsgEnableMsgTypes{},
+		&MsgFreezeAccount{},
+		&MsgUnfreezeAccount{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AddSuperProposal{},
+		&DeleteSuperProposal{},
+		&ChangeSuperTypeProposal{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
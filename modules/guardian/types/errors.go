@@ -10,4 +10,19 @@ var (
 	ErrUnknownSuper       = sdkerrors.Register(ModuleName, 3, "unknown super")
 	ErrSuperExists        = sdkerrors.Register(ModuleName, 5, "super already exists")
 	ErrDeleteGenesisSuper = sdkerrors.Register(ModuleName, 7, "can't delete genesis super")
+	ErrInvalidRole        = sdkerrors.Register(ModuleName, 8, "invalid role")
+	ErrLastGenesisSuper   = sdkerrors.Register(ModuleName, 9, "can't remove the last genesis super")
+	ErrInvalidExpiry      = sdkerrors.Register(ModuleName, 10, "invalid expiry")
+	ErrUnknownAction      = sdkerrors.Register(ModuleName, 11, "unknown pending action")
+	ErrActionExpired      = sdkerrors.Register(ModuleName, 12, "pending action expired")
+	ErrAlreadyApproved    = sdkerrors.Register(ModuleName, 13, "pending action already approved")
+	ErrInvalidParams      = sdkerrors.Register(ModuleName, 14, "invalid params")
+	ErrNoGenesisSuper     = sdkerrors.Register(ModuleName, 15, "no genesis super")
+	ErrInvalidMsgTypeURL  = sdkerrors.Register(ModuleName, 16, "invalid msg type url")
+	ErrMsgTypeDisabled    = sdkerrors.Register(ModuleName, 17, "msg type disabled")
+	ErrMsgTypeNotDisabled = sdkerrors.Register(ModuleName, 18, "msg type not disabled")
+	ErrAccountFrozen      = sdkerrors.Register(ModuleName, 19, "account frozen")
+	ErrAccountNotFrozen   = sdkerrors.Register(ModuleName, 20, "account not frozen")
+	ErrFreezeSuper        = sdkerrors.Register(ModuleName, 21, "can't freeze super")
+	ErrGenesisThreshold   = sdkerrors.Register(ModuleName, 22, "genesis supers below the genesis threshold")
 )
@@ -3,12 +3,40 @@ package types
 
 // guardian module event types
 const (
-	EventTypeAddSuper    = "add_super"
-	EventTypeDeleteSuper = "delete_super"
+	EventTypeAddSuper        = "add_super"
+	EventTypeDeleteSuper     = "delete_super"
+	EventTypeSetRoles        = "set_roles"
+	EventTypeChangeSuperType = "change_super_type"
+	EventTypeExpireSuper     = "expire_super"
+	EventTypeProposeAction   = "propose_action"
+	EventTypeApproveAction   = "approve_action"
+	EventTypeExpireAction    = "expire_action"
+	EventTypeUpdateSuper     = "update_super"
+	EventTypeDisableMsgType  = "disable_msg_type"
+	EventTypeEnableMsgType   = "enable_msg_type"
+	EventTypeFreezeAccount   = "freeze_account"
+	EventTypeUnfreezeAccount = "unfreeze_account"
 
-	AttributeKeySuperAddress = "address"
-	AttributeKeyAddedBy      = "added_by"
-	AttributeKeyDeletedBy    = "deleted_by"
+	AttributeKeySuperAddress    = "address"
+	AttributeKeyAddedBy         = "added_by"
+	AttributeKeyDeletedBy       = "deleted_by"
+	AttributeKeySetBy           = "set_by"
+	AttributeKeyRoles           = "roles"
+	AttributeKeyAccountType     = "account_type"
+	AttributeKeyExpiryTime      = "expiry_time"
+	AttributeKeyExpiryHeight    = "expiry_height"
+	AttributeKeyActionID        = "action_id"
+	AttributeKeyActionType      = "action_type"
+	AttributeKeyProposer        = "proposer"
+	AttributeKeyApprover        = "approver"
+	AttributeKeyApprovals       = "approvals"
+	AttributeKeyDescription     = "description"
+	AttributeKeyWebsite         = "website"
+	AttributeKeySecurityContact = "security_contact"
+	AttributeKeyMsgTypeURL      = "msg_type_url"
+	AttributeKeyOperator        = "operator"
+	AttributeKeyAccount         = "account"
+	AttributeKeyReason          = "reason"
 
 	AttributeValueCategory = ModuleName
 )
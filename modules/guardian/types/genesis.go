@@ -1,13 +1,102 @@
 package types
 
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // NewGenesisState constructs a GenesisState
-func NewGenesisState(supers []Super) *GenesisState {
+func NewGenesisState(
+	supers []Super, params Params, pendingActions []PendingAction, nextActionID uint64, auditLog []AuditRecord,
+	disabledMsgTypes []string, frozenAccounts []FrozenAccount,
+) *GenesisState {
 	return &GenesisState{
-		Supers: supers,
+		Supers:           supers,
+		Params:           params,
+		PendingActions:   pendingActions,
+		NextActionId:     nextActionID,
+		AuditLog:         auditLog,
+		DisabledMsgTypes: disabledMsgTypes,
+		FrozenAccounts:   frozenAccounts,
 	}
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params:       DefaultParams(),
+		NextActionId: 1,
+	}
+}
+
+// ValidateGenesis validates the provided guardian genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+
+	addresses := make(map[string]bool)
+	for _, super := range data.Supers {
+		if err := super.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "super %s", super.Address)
+		}
+		if addresses[super.Address] {
+			return sdkerrors.Wrapf(ErrSuperExists, "duplicated super %s", super.Address)
+		}
+		addresses[super.Address] = true
+	}
+	if err := ValidateAdders(data.Supers, KnownAdders(data.Supers, data.AuditLog)); err != nil {
+		return err
+	}
+	if err := ValidateGenesisSuperExists(data.Supers); err != nil {
+		return err
+	}
+	if err := ValidateGenesisThreshold(data.Supers, data.Params.GenesisThreshold); err != nil {
+		return err
+	}
+
+	seen := make(map[uint64]bool)
+	for _, action := range data.PendingActions {
+		if err := action.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "pending action %d", action.Id)
+		}
+		if seen[action.Id] {
+			return sdkerrors.Wrapf(ErrUnknownAction, "duplicated pending action %d", action.Id)
+		}
+		if action.Id >= data.NextActionId {
+			return sdkerrors.Wrapf(ErrUnknownAction, "pending action %d is not below the next action id %d", action.Id, data.NextActionId)
+		}
+		seen[action.Id] = true
+	}
+
+	// the audit log is append-only, the records are in the order of the ids
+	var lastID uint64
+	for _, record := range data.AuditLog {
+		if err := record.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "audit record %d", record.Id)
+		}
+		if record.Id <= lastID {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "audit record %d is out of order", record.Id)
+		}
+		lastID = record.Id
+	}
+
+	if err := ValidateMsgTypeURLs(data.DisabledMsgTypes); err != nil {
+		return err
+	}
+
+	frozen := make(map[string]bool)
+	for _, account := range data.FrozenAccounts {
+		if err := account.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "frozen account %s", account.Address)
+		}
+		if frozen[account.Address] {
+			return sdkerrors.Wrapf(ErrAccountFrozen, "duplicated frozen account %s", account.Address)
+		}
+		// the supers can't be frozen, so that they can always operate
+		if addresses[account.Address] {
+			return sdkerrors.Wrapf(ErrFreezeSuper, "frozen account %s is a super", account.Address)
+		}
+		frozen[account.Address] = true
+	}
+	return nil
 }
@@ -25,7 +25,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the guardian module's genesis state.
 type GenesisState struct {
-	Supers []Super `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
+	Supers           []Super         `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
+	Params           Params          `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	PendingActions   []PendingAction `protobuf:"bytes,3,rep,name=pending_actions,json=pendingActions,proto3" json:"pending_actions" yaml:"pending_actions"`
+	NextActionId     uint64          `protobuf:"varint,4,opt,name=next_action_id,json=nextActionId,proto3" json:"next_action_id,omitempty" yaml:"next_action_id"`
+	AuditLog         []AuditRecord   `protobuf:"bytes,5,rep,name=audit_log,json=auditLog,proto3" json:"audit_log" yaml:"audit_log"`
+	DisabledMsgTypes []string        `protobuf:"bytes,6,rep,name=disabled_msg_types,json=disabledMsgTypes,proto3" json:"disabled_msg_types,omitempty" yaml:"disabled_msg_types"`
+	FrozenAccounts   []FrozenAccount `protobuf:"bytes,7,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts" yaml:"frozen_accounts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +74,48 @@ func (m *GenesisState) GetSupers() []Super {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPendingActions() []PendingAction {
+	if m != nil {
+		return m.PendingActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextActionId() uint64 {
+	if m != nil {
+		return m.NextActionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAuditLog() []AuditRecord {
+	if m != nil {
+		return m.AuditLog
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisabledMsgTypes() []string {
+	if m != nil {
+		return m.DisabledMsgTypes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenAccounts() []FrozenAccount {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "irishub.guardian.GenesisState")
 }
@@ -75,20 +123,34 @@ func init() {
 func init() { proto.RegisterFile("guardian/genesis.proto", fileDescriptor_5203106ad1456439) }
 
 var fileDescriptor_5203106ad1456439 = []byte{
-	// 195 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0xc8, 0x2c, 0xca, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x83, 0xc9, 0x4b, 0x89,
-	0x23, 0x54, 0x42, 0x19, 0x10, 0xa5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0x72, 0xe5, 0xe2, 0x71, 0x87, 0x98, 0x18, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64,
-	0xca, 0xc5, 0x56, 0x5c, 0x5a, 0x90, 0x5a, 0x54, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24,
-	0xae, 0x87, 0x6e, 0x83, 0x5e, 0x30, 0x48, 0xde, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8,
-	0x62, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf,
-	0x2c, 0x01, 0x69, 0x4f, 0xce, 0xcf, 0xd5, 0x07, 0x19, 0x95, 0x97, 0x5a, 0xa2, 0x0f, 0x35, 0x52,
-	0x3f, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0xb5, 0x18, 0xee, 0x52, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0xd3, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x50, 0x6e, 0x87, 0x2a, 0xf5,
-	0x00, 0x00, 0x00,
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x5a, 0x0a, 0xf3, 0xa6, 0x52, 0x59, 0xb0, 0x79, 0x93, 0x96, 0x54, 0x39, 0xf5,
+	0xd4, 0x88, 0x21, 0x38, 0x70, 0x41, 0xcd, 0x01, 0x84, 0x06, 0x12, 0xca, 0x76, 0xe2, 0x12, 0xb9,
+	0xb1, 0xe7, 0x59, 0x4a, 0xec, 0x28, 0x76, 0x24, 0xc6, 0x53, 0xf0, 0x32, 0xbc, 0xc3, 0x8e, 0x3b,
+	0x72, 0x8a, 0x50, 0xfb, 0x06, 0x7d, 0x02, 0x64, 0x3b, 0x1d, 0x25, 0x65, 0xb7, 0x4f, 0xdf, 0xf7,
+	0xfb, 0xff, 0xff, 0x9f, 0x3f, 0x19, 0x1c, 0xb2, 0x1a, 0x57, 0x84, 0x63, 0x11, 0x31, 0x2a, 0xa8,
+	0xe2, 0x6a, 0x56, 0x56, 0x52, 0x4b, 0x38, 0xe6, 0x15, 0x57, 0xd7, 0xf5, 0x62, 0xb6, 0x99, 0x9f,
+	0x1c, 0xfd, 0x25, 0xdb, 0xc2, 0xa1, 0x27, 0xcf, 0x99, 0x64, 0xd2, 0x96, 0x91, 0xa9, 0x5c, 0x37,
+	0xfc, 0x39, 0x00, 0x07, 0x1f, 0x9c, 0xe5, 0x85, 0xc6, 0x9a, 0xc2, 0xd7, 0x60, 0xa8, 0xea, 0x92,
+	0x56, 0x0a, 0x79, 0x93, 0xfe, 0x74, 0xff, 0xec, 0x68, 0xd6, 0x8d, 0x98, 0x5d, 0x98, 0x79, 0x3c,
+	0xb8, 0x6d, 0x82, 0x5e, 0xd2, 0xc2, 0xf0, 0x0d, 0x18, 0x96, 0xb8, 0xc2, 0x85, 0x42, 0x8f, 0x26,
+	0xde, 0x74, 0xff, 0x0c, 0xed, 0xca, 0xbe, 0xd8, 0xf9, 0x46, 0xe7, 0x68, 0x78, 0x0d, 0x9e, 0x95,
+	0x54, 0x10, 0x2e, 0x58, 0x8a, 0x33, 0xcd, 0xa5, 0x50, 0xa8, 0x6f, 0x73, 0x83, 0xff, 0x18, 0x38,
+	0x70, 0x6e, 0xb9, 0xd8, 0x37, 0x3e, 0xeb, 0x26, 0x38, 0xbc, 0xc1, 0x45, 0xfe, 0x36, 0xec, 0xb8,
+	0x84, 0xc9, 0xa8, 0xdc, 0xc6, 0x15, 0x7c, 0x07, 0x46, 0x82, 0x7e, 0xd3, 0x2d, 0x90, 0x72, 0x82,
+	0x06, 0x13, 0x6f, 0x3a, 0x88, 0x8f, 0xd7, 0x4d, 0xf0, 0xc2, 0x79, 0xfc, 0x3b, 0x0f, 0x93, 0x03,
+	0xd3, 0x70, 0xfa, 0x8f, 0x04, 0x5e, 0x82, 0x3d, 0x5c, 0x13, 0xae, 0xd3, 0x5c, 0x32, 0xf4, 0xd8,
+	0x2e, 0x79, 0xba, 0xbb, 0xe4, 0xdc, 0x20, 0x09, 0xcd, 0x64, 0x45, 0x62, 0xd4, 0xae, 0x38, 0x76,
+	0xf6, 0xf7, 0xea, 0x30, 0x79, 0x6a, 0xeb, 0x4f, 0x92, 0xc1, 0x73, 0x00, 0x09, 0x57, 0x78, 0x91,
+	0x53, 0x92, 0x16, 0x8a, 0xa5, 0xfa, 0xa6, 0xa4, 0x0a, 0x0d, 0x27, 0xfd, 0xe9, 0x5e, 0x7c, 0xba,
+	0x6e, 0x82, 0x63, 0xa7, 0xdd, 0x65, 0xc2, 0x64, 0xbc, 0x69, 0x7e, 0x56, 0xec, 0xd2, 0xb4, 0xcc,
+	0x35, 0xaf, 0x2a, 0xf9, 0x9d, 0x8a, 0x14, 0x67, 0x99, 0xac, 0x85, 0x56, 0xe8, 0xc9, 0x43, 0xd7,
+	0x7c, 0x6f, 0xc1, 0xb9, 0xe3, 0xba, 0xd7, 0xec, 0xb8, 0x84, 0xc9, 0xe8, 0x6a, 0x1b, 0x57, 0xf1,
+	0xf9, 0xed, 0xd2, 0xf7, 0xee, 0x96, 0xbe, 0xf7, 0x7b, 0xe9, 0x7b, 0x3f, 0x56, 0x7e, 0xef, 0x6e,
+	0xe5, 0xf7, 0x7e, 0xad, 0xfc, 0xde, 0xd7, 0x97, 0x8c, 0x6b, 0x13, 0x94, 0xc9, 0x22, 0x32, 0xa1,
+	0x82, 0xea, 0xa8, 0x0d, 0x8f, 0x0a, 0x49, 0xea, 0x9c, 0xaa, 0xfb, 0xaf, 0x19, 0xd9, 0x97, 0x2c,
+	0x86, 0xf6, 0x2f, 0xbe, 0xfa, 0x33, 0x00, 0xbe, 0xe5, 0x38, 0xba, 0xe6, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +173,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DisabledMsgTypes) > 0 {
+		for iNdEx := len(m.DisabledMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMsgTypes[iNdEx])
+			copy(dAtA[i:], m.DisabledMsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DisabledMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AuditLog) > 0 {
+		for iNdEx := len(m.AuditLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NextActionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextActionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PendingActions) > 0 {
+		for iNdEx := len(m.PendingActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Supers) > 0 {
 		for iNdEx := len(m.Supers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -151,6 +279,35 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingActions) > 0 {
+		for _, e := range m.PendingActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextActionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextActionId))
+	}
+	if len(m.AuditLog) > 0 {
+		for _, e := range m.AuditLog {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DisabledMsgTypes) > 0 {
+		for _, s := range m.DisabledMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, e := range m.FrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -223,6 +380,192 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingActions = append(m.PendingActions, PendingAction{})
+			if err := m.PendingActions[len(m.PendingActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextActionId", wireType)
+			}
+			m.NextActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditLog = append(m.AuditLog, AuditRecord{})
+			if err := m.AuditLog[len(m.AuditLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgTypes = append(m.DisabledMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, FrozenAccount{})
+			if err := m.FrozenAccounts[len(m.FrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
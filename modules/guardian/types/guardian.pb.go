@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -51,12 +57,149 @@ func (AccountType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_07c8fad859e95e75, []int{0}
 }
 
+// Role defines the scoped permission granted to a super
+type Role int32
+
+const (
+	// ROLE_UNSPECIFIED defines an unspecified role
+	RoleUnspecified Role = 0
+	// ROLE_ORACLE_OPERATOR defines the role to operate oracle feeds
+	RoleOracleOperator Role = 1
+	// ROLE_SERVICE_ADMIN defines the role to administer the service module
+	RoleServiceAdmin Role = 2
+	// ROLE_TOKEN_ADMIN defines the role to administer the token module
+	RoleTokenAdmin Role = 3
+	// ROLE_UPGRADE_OPERATOR defines the role to operate software upgrades
+	RoleUpgradeOperator Role = 4
+	// ROLE_CIRCUIT_BREAKER defines the role to disable and re-enable msg types in an emergency
+	RoleCircuitBreaker Role = 5
+	// ROLE_BLOCKLIST_ADMIN defines the role to freeze and unfreeze accounts
+	RoleBlocklistAdmin Role = 6
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_ORACLE_OPERATOR",
+	2: "ROLE_SERVICE_ADMIN",
+	3: "ROLE_TOKEN_ADMIN",
+	4: "ROLE_UPGRADE_OPERATOR",
+	5: "ROLE_CIRCUIT_BREAKER",
+	6: "ROLE_BLOCKLIST_ADMIN",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":      0,
+	"ROLE_ORACLE_OPERATOR":  1,
+	"ROLE_SERVICE_ADMIN":    2,
+	"ROLE_TOKEN_ADMIN":      3,
+	"ROLE_UPGRADE_OPERATOR": 4,
+	"ROLE_CIRCUIT_BREAKER":  5,
+	"ROLE_BLOCKLIST_ADMIN":  6,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{1}
+}
+
+// ActionType defines the type of a pending action
+type ActionType int32
+
+const (
+	// ACTION_TYPE_UNSPECIFIED defines an unspecified action type
+	ActionUnspecified ActionType = 0
+	// ACTION_TYPE_ADD_SUPER defines the action to add a super
+	ActionAddSuper ActionType = 1
+	// ACTION_TYPE_DELETE_SUPER defines the action to delete a super
+	ActionDeleteSuper ActionType = 2
+	// ACTION_TYPE_SET_ROLES defines the action to set the roles of a super
+	ActionSetRoles ActionType = 3
+)
+
+var ActionType_name = map[int32]string{
+	0: "ACTION_TYPE_UNSPECIFIED",
+	1: "ACTION_TYPE_ADD_SUPER",
+	2: "ACTION_TYPE_DELETE_SUPER",
+	3: "ACTION_TYPE_SET_ROLES",
+}
+
+var ActionType_value = map[string]int32{
+	"ACTION_TYPE_UNSPECIFIED":  0,
+	"ACTION_TYPE_ADD_SUPER":    1,
+	"ACTION_TYPE_DELETE_SUPER": 2,
+	"ACTION_TYPE_SET_ROLES":    3,
+}
+
+func (x ActionType) String() string {
+	return proto.EnumName(ActionType_name, int32(x))
+}
+
+func (ActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{2}
+}
+
+// AuditOperation defines the change of the supers recorded in the audit log
+type AuditOperation int32
+
+const (
+	// AUDIT_OPERATION_UNSPECIFIED defines an unspecified operation
+	OperationUnspecified AuditOperation = 0
+	// AUDIT_OPERATION_ADD_SUPER defines the addition of a super
+	OperationAddSuper AuditOperation = 1
+	// AUDIT_OPERATION_DELETE_SUPER defines the deletion of a super
+	OperationDeleteSuper AuditOperation = 2
+	// AUDIT_OPERATION_EXPIRE_SUPER defines the removal of a super on its expiry
+	OperationExpireSuper AuditOperation = 3
+	// AUDIT_OPERATION_SET_ROLES defines the change of the roles of a super
+	OperationSetRoles AuditOperation = 4
+	// AUDIT_OPERATION_CHANGE_SUPER_TYPE defines the change of the account type of a super
+	OperationChangeSuperType AuditOperation = 5
+)
+
+var AuditOperation_name = map[int32]string{
+	0: "AUDIT_OPERATION_UNSPECIFIED",
+	1: "AUDIT_OPERATION_ADD_SUPER",
+	2: "AUDIT_OPERATION_DELETE_SUPER",
+	3: "AUDIT_OPERATION_EXPIRE_SUPER",
+	4: "AUDIT_OPERATION_SET_ROLES",
+	5: "AUDIT_OPERATION_CHANGE_SUPER_TYPE",
+}
+
+var AuditOperation_value = map[string]int32{
+	"AUDIT_OPERATION_UNSPECIFIED":       0,
+	"AUDIT_OPERATION_ADD_SUPER":         1,
+	"AUDIT_OPERATION_DELETE_SUPER":      2,
+	"AUDIT_OPERATION_EXPIRE_SUPER":      3,
+	"AUDIT_OPERATION_SET_ROLES":         4,
+	"AUDIT_OPERATION_CHANGE_SUPER_TYPE": 5,
+}
+
+func (x AuditOperation) String() string {
+	return proto.EnumName(AuditOperation_name, int32(x))
+}
+
+func (AuditOperation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{3}
+}
+
 // Super defines the super standard
 type Super struct {
 	Description string      `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
 	AccountType AccountType `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=irishub.guardian.AccountType" json:"account_type,omitempty" yaml:"account_type"`
 	Address     string      `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	AddedBy     string      `protobuf:"bytes,4,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	Roles       []Role      `protobuf:"varint,5,rep,packed,name=roles,proto3,enum=irishub.guardian.Role" json:"roles,omitempty"`
+	// the super expires at the time, if set
+	ExpiryTime *time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" yaml:"expiry_time"`
+	// the super expires at the height, if not zero
+	ExpiryHeight int64 `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// the website of the super operator
+	Website string `protobuf:"bytes,8,opt,name=website,proto3" json:"website,omitempty"`
+	// the security contact of the super operator
+	SecurityContact string `protobuf:"bytes,9,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty" yaml:"security_contact"`
 }
 
 func (m *Super) Reset()         { *m = Super{} }
@@ -120,129 +263,3043 @@ func (m *Super) GetAddedBy() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("irishub.guardian.AccountType", AccountType_name, AccountType_value)
-	proto.RegisterType((*Super)(nil), "irishub.guardian.Super")
+func (m *Super) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("guardian/guardian.proto", fileDescriptor_07c8fad859e95e75) }
+func (m *Super) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
 
-var fileDescriptor_07c8fad859e95e75 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x87, 0x31, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x32,
-	0x8b, 0x32, 0x8b, 0x33, 0x4a, 0x93, 0xf4, 0x60, 0xe2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60,
-	0x49, 0x7d, 0x10, 0x0b, 0xa2, 0x4e, 0x69, 0x27, 0x23, 0x17, 0x6b, 0x70, 0x69, 0x41, 0x6a, 0x91,
-	0x90, 0x02, 0x17, 0x77, 0x4a, 0x6a, 0x71, 0x72, 0x51, 0x66, 0x41, 0x49, 0x66, 0x7e, 0x9e, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0xb2, 0x90, 0x50, 0x24, 0x17, 0x4f, 0x62, 0x72, 0x72, 0x7e,
-	0x69, 0x5e, 0x49, 0x7c, 0x49, 0x65, 0x41, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x9f, 0x91, 0xac,
-	0x1e, 0xba, 0x55, 0x7a, 0x8e, 0x10, 0x55, 0x21, 0x95, 0x05, 0xa9, 0x4e, 0xe2, 0x9f, 0xee, 0xc9,
-	0x0b, 0x57, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0x21, 0x6b, 0x56, 0x0a, 0xe2, 0x4e, 0x44, 0xa8, 0x12,
-	0x92, 0xe0, 0x62, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x06, 0x5b, 0x0c, 0xe3,
-	0x0a, 0x49, 0x72, 0x71, 0x24, 0xa6, 0xa4, 0xa4, 0xa6, 0xc4, 0x27, 0x55, 0x4a, 0xb0, 0xc0, 0xa5,
-	0x52, 0x53, 0x9c, 0x2a, 0xb5, 0x3c, 0xb9, 0xb8, 0x1d, 0x51, 0xcd, 0x70, 0x77, 0xf5, 0x73, 0x0d,
-	0xf6, 0x0c, 0x16, 0x60, 0x90, 0xe2, 0xee, 0x9a, 0xab, 0xc0, 0xee, 0x9e, 0x9a, 0x97, 0x5a, 0x9c,
-	0x59, 0x2c, 0x24, 0xc5, 0xc5, 0xe1, 0x1f, 0xe4, 0xe2, 0xe9, 0xe7, 0x18, 0x14, 0x29, 0xc0, 0x28,
-	0xc5, 0xd3, 0x35, 0x57, 0x81, 0xc3, 0xbf, 0x28, 0x25, 0x33, 0x2f, 0xb1, 0xa8, 0x52, 0x8a, 0xa5,
-	0x63, 0xb1, 0x1c, 0x83, 0x93, 0xf7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0x19, 0xa6, 0x67, 0x96, 0x80, 0x3c, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xf2, 0x68, 0x5e, 0x6a, 0x89,
-	0x3e, 0xd4, 0xc3, 0xfa, 0xb9, 0xf9, 0x29, 0xa5, 0x39, 0xa9, 0xc5, 0xf0, 0xb0, 0xd7, 0x07, 0xf9,
-	0xac, 0x38, 0x89, 0x0d, 0x1c, 0xb4, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x96, 0xfb,
-	0xdc, 0x9d, 0x01, 0x00, 0x00,
+func (m *Super) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
 }
 
-func (m *Super) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Super) GetWebsite() string {
+	if m != nil {
+		return m.Website
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Super) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Super) GetSecurityContact() string {
+	if m != nil {
+		return m.SecurityContact
+	}
+	return ""
 }
 
-func (m *Super) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddedBy) > 0 {
-		i -= len(m.AddedBy)
-		copy(dAtA[i:], m.AddedBy)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.AddedBy)))
-		i--
-		dAtA[i] = 0x22
+// Params defines the parameters for the guardian module
+type Params struct {
+	// the number of genesis super approvals required to add or delete a super
+	GenesisThreshold uint32 `protobuf:"varint,1,opt,name=genesis_threshold,json=genesisThreshold,proto3" json:"genesis_threshold,omitempty" yaml:"genesis_threshold"`
+	// the period within which a pending action must reach the threshold
+	PendingActionTimeout time.Duration `protobuf:"bytes,2,opt,name=pending_action_timeout,json=pendingActionTimeout,proto3,stdduration" json:"pending_action_timeout" yaml:"pending_action_timeout"`
+	// the type urls of the msgs whose fees are discounted when signed by supers
+	FeeExemptMsgTypes []string `protobuf:"bytes,3,rep,name=fee_exempt_msg_types,json=feeExemptMsgTypes,proto3" json:"fee_exempt_msg_types,omitempty" yaml:"fee_exempt_msg_types"`
+	// the fraction of the min fee waived for the fee exempt txs, 1 makes them gas-free
+	FeeDiscount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee_discount,json=feeDiscount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_discount" yaml:"fee_discount"`
+	// the max number of fee exempt txs a signer can send per block
+	MaxFeeExemptTxsPerBlock uint32 `protobuf:"varint,5,opt,name=max_fee_exempt_txs_per_block,json=maxFeeExemptTxsPerBlock,proto3" json:"max_fee_exempt_txs_per_block,omitempty" yaml:"max_fee_exempt_txs_per_block"`
+	// the role a super needs for its txs to be fee exempt
+	FeeExemptRole Role `protobuf:"varint,6,opt,name=fee_exempt_role,json=feeExemptRole,proto3,enum=irishub.guardian.Role" json:"fee_exempt_role,omitempty" yaml:"fee_exempt_role"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetGenesisThreshold() uint32 {
+	if m != nil {
+		return m.GenesisThreshold
 	}
-	if m.AccountType != 0 {
-		i = encodeVarintGuardian(dAtA, i, uint64(m.AccountType))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *Params) GetPendingActionTimeout() time.Duration {
+	if m != nil {
+		return m.PendingActionTimeout
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Params) GetFeeExemptMsgTypes() []string {
+	if m != nil {
+		return m.FeeExemptMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxFeeExemptTxsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxFeeExemptTxsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetFeeExemptRole() Role {
+	if m != nil {
+		return m.FeeExemptRole
+	}
+	return RoleUnspecified
+}
+
+// PendingAction defines a change of the supers awaiting the approvals of the genesis supers
+type PendingAction struct {
+	Id         uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActionType ActionType `protobuf:"varint,2,opt,name=action_type,json=actionType,proto3,enum=irishub.guardian.ActionType" json:"action_type,omitempty" yaml:"action_type"`
+	// the address of the super to add, delete or set the roles of
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// the description of the super to add
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// the roles of the super to add, or the roles to set
+	Roles []Role `protobuf:"varint,5,rep,packed,name=roles,proto3,enum=irishub.guardian.Role" json:"roles,omitempty"`
+	// the expiry time of the super to add, if set
+	ExpiryTime *time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" yaml:"expiry_time"`
+	// the expiry height of the super to add, if not zero
+	ExpiryHeight int64  `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	Proposer     string `protobuf:"bytes,8,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the genesis supers which approved the action, the proposer included
+	Approvals []string `protobuf:"bytes,9,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// the action is dropped if the threshold is not met by the time
+	Deadline time.Time `protobuf:"bytes,10,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *PendingAction) Reset()         { *m = PendingAction{} }
+func (m *PendingAction) String() string { return proto.CompactTextString(m) }
+func (*PendingAction) ProtoMessage()    {}
+func (*PendingAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{2}
+}
+func (m *PendingAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAction.Merge(m, src)
+}
+func (m *PendingAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAction proto.InternalMessageInfo
+
+func (m *PendingAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingAction) GetActionType() ActionType {
+	if m != nil {
+		return m.ActionType
+	}
+	return ActionUnspecified
+}
+
+func (m *PendingAction) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PendingAction) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PendingAction) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *PendingAction) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+func (m *PendingAction) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *PendingAction) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *PendingAction) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *PendingAction) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+// AuditRecord defines an entry of the append-only audit log of the changes of the supers
+type AuditRecord struct {
+	Id        uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operation AuditOperation `protobuf:"varint,2,opt,name=operation,proto3,enum=irishub.guardian.AuditOperation" json:"operation,omitempty"`
+	// the address which made the change, empty for the expiry
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// the address of the super changed
+	Target string    `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Height int64     `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *AuditRecord) Reset()         { *m = AuditRecord{} }
+func (m *AuditRecord) String() string { return proto.CompactTextString(m) }
+func (*AuditRecord) ProtoMessage()    {}
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{3}
+}
+func (m *AuditRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRecord.Merge(m, src)
+}
+func (m *AuditRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRecord proto.InternalMessageInfo
+
+func (m *AuditRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditRecord) GetOperation() AuditOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return OperationUnspecified
+}
+
+func (m *AuditRecord) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AuditRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// FrozenAccount defines an account on the blocklist, the txs signed by which are rejected
+type FrozenAccount struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the super which froze the account
+	FrozenBy string `protobuf:"bytes,2,opt,name=frozen_by,json=frozenBy,proto3" json:"frozen_by,omitempty" yaml:"frozen_by"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height   int64  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FrozenAccount) Reset()         { *m = FrozenAccount{} }
+func (m *FrozenAccount) String() string { return proto.CompactTextString(m) }
+func (*FrozenAccount) ProtoMessage()    {}
+func (*FrozenAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{4}
+}
+func (m *FrozenAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenAccount.Merge(m, src)
+}
+func (m *FrozenAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenAccount proto.InternalMessageInfo
+
+func (m *FrozenAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FrozenAccount) GetFrozenBy() string {
+	if m != nil {
+		return m.FrozenBy
+	}
+	return ""
+}
+
+func (m *FrozenAccount) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FrozenAccount) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// AddSuperProposal defines a proposal to add a super of any account type
+type AddSuperProposal struct {
+	Title            string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Address          string      `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	AccountType      AccountType `protobuf:"varint,4,opt,name=account_type,json=accountType,proto3,enum=irishub.guardian.AccountType" json:"account_type,omitempty" yaml:"account_type"`
+	SuperDescription string      `protobuf:"bytes,5,opt,name=super_description,json=superDescription,proto3" json:"super_description,omitempty" yaml:"super_description"`
+	Roles            []Role      `protobuf:"varint,6,rep,packed,name=roles,proto3,enum=irishub.guardian.Role" json:"roles,omitempty"`
+}
+
+func (m *AddSuperProposal) Reset()      { *m = AddSuperProposal{} }
+func (*AddSuperProposal) ProtoMessage() {}
+func (*AddSuperProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{5}
+}
+func (m *AddSuperProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddSuperProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddSuperProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddSuperProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSuperProposal.Merge(m, src)
+}
+func (m *AddSuperProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddSuperProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSuperProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddSuperProposal proto.InternalMessageInfo
+
+// DeleteSuperProposal defines a proposal to delete a super of any account type
+type DeleteSuperProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Address     string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *DeleteSuperProposal) Reset()      { *m = DeleteSuperProposal{} }
+func (*DeleteSuperProposal) ProtoMessage() {}
+func (*DeleteSuperProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{6}
+}
+func (m *DeleteSuperProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSuperProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSuperProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteSuperProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSuperProposal.Merge(m, src)
+}
+func (m *DeleteSuperProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteSuperProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSuperProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSuperProposal proto.InternalMessageInfo
+
+// ChangeSuperTypeProposal defines a proposal to change the account type of a super
+type ChangeSuperTypeProposal struct {
+	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Address     string      `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	AccountType AccountType `protobuf:"varint,4,opt,name=account_type,json=accountType,proto3,enum=irishub.guardian.AccountType" json:"account_type,omitempty" yaml:"account_type"`
+}
+
+func (m *ChangeSuperTypeProposal) Reset()      { *m = ChangeSuperTypeProposal{} }
+func (*ChangeSuperTypeProposal) ProtoMessage() {}
+func (*ChangeSuperTypeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{7}
+}
+func (m *ChangeSuperTypeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeSuperTypeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeSuperTypeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChangeSuperTypeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeSuperTypeProposal.Merge(m, src)
+}
+func (m *ChangeSuperTypeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeSuperTypeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeSuperTypeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeSuperTypeProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("irishub.guardian.AccountType", AccountType_name, AccountType_value)
+	proto.RegisterEnum("irishub.guardian.Role", Role_name, Role_value)
+	proto.RegisterEnum("irishub.guardian.ActionType", ActionType_name, ActionType_value)
+	proto.RegisterEnum("irishub.guardian.AuditOperation", AuditOperation_name, AuditOperation_value)
+	proto.RegisterType((*Super)(nil), "irishub.guardian.Super")
+	proto.RegisterType((*Params)(nil), "irishub.guardian.Params")
+	proto.RegisterType((*PendingAction)(nil), "irishub.guardian.PendingAction")
+	proto.RegisterType((*AuditRecord)(nil), "irishub.guardian.AuditRecord")
+	proto.RegisterType((*FrozenAccount)(nil), "irishub.guardian.FrozenAccount")
+	proto.RegisterType((*AddSuperProposal)(nil), "irishub.guardian.AddSuperProposal")
+	proto.RegisterType((*DeleteSuperProposal)(nil), "irishub.guardian.DeleteSuperProposal")
+	proto.RegisterType((*ChangeSuperTypeProposal)(nil), "irishub.guardian.ChangeSuperTypeProposal")
+}
+
+func init() { proto.RegisterFile("guardian/guardian.proto", fileDescriptor_07c8fad859e95e75) }
+
+var fileDescriptor_07c8fad859e95e75 = []byte{
+	// 1551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x6f, 0xdb, 0xc6,
+	0x16, 0x15, 0xf5, 0xe1, 0xd8, 0x23, 0xdb, 0x91, 0xc7, 0x8a, 0xcd, 0x28, 0x8e, 0xc8, 0xc7, 0x07,
+	0xbc, 0xe7, 0x04, 0x89, 0xd4, 0x38, 0x5d, 0xb4, 0x06, 0x5a, 0x94, 0x92, 0x68, 0x47, 0xb0, 0x63,
+	0x09, 0x23, 0xb9, 0x6d, 0xb2, 0x21, 0x68, 0x72, 0x2c, 0x13, 0x96, 0x44, 0x82, 0xa4, 0x52, 0x2b,
+	0xbf, 0x20, 0x10, 0xba, 0xc8, 0x32, 0x1b, 0x01, 0x01, 0xfa, 0x5f, 0x8a, 0x2c, 0xb3, 0x68, 0x81,
+	0xb6, 0x40, 0xd5, 0x36, 0xd9, 0x74, 0x2d, 0x74, 0xd5, 0x55, 0x31, 0x33, 0xa4, 0x44, 0x53, 0x4e,
+	0x9a, 0x45, 0xbb, 0x68, 0x57, 0xd6, 0x9d, 0x39, 0x67, 0xe6, 0xde, 0x73, 0x3f, 0x86, 0x06, 0xeb,
+	0xad, 0x9e, 0xe6, 0x18, 0xa6, 0xd6, 0x2d, 0x06, 0x3f, 0x0a, 0xb6, 0x63, 0x79, 0x16, 0xcc, 0x98,
+	0x8e, 0xe9, 0x9e, 0xf4, 0x8e, 0x0a, 0xc1, 0x7a, 0x2e, 0xdb, 0xb2, 0x5a, 0x16, 0xdd, 0x2c, 0x92,
+	0x5f, 0x0c, 0x97, 0xcb, 0xb7, 0x2c, 0xab, 0xd5, 0xc6, 0x45, 0x6a, 0x1d, 0xf5, 0x8e, 0x8b, 0x46,
+	0xcf, 0xd1, 0x3c, 0xd3, 0xf2, 0xcf, 0xc9, 0x09, 0xd1, 0x7d, 0xcf, 0xec, 0x60, 0xd7, 0xd3, 0x3a,
+	0x36, 0x03, 0x48, 0xbf, 0x24, 0x40, 0xaa, 0xd1, 0xb3, 0xb1, 0x03, 0x45, 0x90, 0x36, 0xb0, 0xab,
+	0x3b, 0xa6, 0x4d, 0xf8, 0x3c, 0x27, 0x72, 0x9b, 0x0b, 0x28, 0xbc, 0x04, 0x1f, 0x80, 0x45, 0x4d,
+	0xd7, 0xad, 0x5e, 0xd7, 0x53, 0xbd, 0xbe, 0x8d, 0xf9, 0xb8, 0xc8, 0x6d, 0x2e, 0x6f, 0x5d, 0x2f,
+	0x44, 0x7d, 0x2d, 0xc8, 0x0c, 0xd5, 0xec, 0xdb, 0xb8, 0xb4, 0x3e, 0x1e, 0x09, 0xab, 0x7d, 0xad,
+	0xd3, 0xde, 0x96, 0xc2, 0x64, 0x09, 0xa5, 0xb5, 0x29, 0x0a, 0xf2, 0xe0, 0x92, 0x66, 0x18, 0x0e,
+	0x76, 0x5d, 0x3e, 0x41, 0x2f, 0x0e, 0x4c, 0x78, 0x15, 0xcc, 0x6b, 0x86, 0x81, 0x0d, 0xf5, 0xa8,
+	0xcf, 0x27, 0x27, 0x5b, 0xd8, 0x28, 0xf5, 0xe1, 0x2d, 0x90, 0x72, 0xac, 0x36, 0x76, 0xf9, 0x94,
+	0x98, 0xd8, 0x5c, 0xde, 0x5a, 0x9b, 0x75, 0x04, 0x59, 0x6d, 0x8c, 0x18, 0x08, 0x7e, 0x06, 0xd2,
+	0xf8, 0xcc, 0x36, 0x9d, 0xbe, 0x4a, 0x34, 0xe0, 0xe7, 0x44, 0x6e, 0x33, 0xbd, 0x95, 0x2b, 0x30,
+	0x81, 0x0a, 0x81, 0x40, 0x85, 0x66, 0x20, 0x50, 0x29, 0x37, 0x1e, 0x09, 0x90, 0x79, 0x1e, 0x22,
+	0x4a, 0x4f, 0x7f, 0x12, 0x38, 0x04, 0xd8, 0x0a, 0x01, 0xc3, 0x8f, 0xc0, 0x92, 0xbf, 0x7f, 0x82,
+	0xcd, 0xd6, 0x89, 0xc7, 0x5f, 0x12, 0xb9, 0xcd, 0x44, 0x89, 0x1f, 0x8f, 0x84, 0xec, 0x39, 0x3a,
+	0xdb, 0x96, 0xd0, 0x22, 0xb3, 0xef, 0x51, 0x93, 0x84, 0xfe, 0x05, 0x3e, 0x72, 0x4d, 0x0f, 0xf3,
+	0xf3, 0x2c, 0x3e, 0xdf, 0x84, 0x3b, 0x20, 0xe3, 0x62, 0xbd, 0xe7, 0x98, 0x5e, 0x5f, 0xd5, 0xad,
+	0xae, 0xa7, 0xe9, 0x1e, 0xbf, 0x40, 0x20, 0xa5, 0x6b, 0xe3, 0x91, 0xb0, 0xce, 0xce, 0x8e, 0x22,
+	0x24, 0x74, 0x39, 0x58, 0x2a, 0xfb, 0x2b, 0xdf, 0x26, 0xc1, 0x5c, 0x5d, 0x73, 0xb4, 0x8e, 0x0b,
+	0xab, 0x60, 0xa5, 0x85, 0xbb, 0xd8, 0x35, 0x5d, 0xd5, 0x3b, 0x71, 0xb0, 0x7b, 0x62, 0xb5, 0x0d,
+	0x9a, 0xea, 0xa5, 0xd2, 0xc6, 0x78, 0x24, 0xf0, 0xec, 0xcc, 0x19, 0x88, 0x84, 0x32, 0xfe, 0x5a,
+	0x33, 0x58, 0x82, 0x8f, 0xc1, 0x9a, 0x8d, 0xbb, 0x86, 0xd9, 0x6d, 0xa9, 0x9a, 0x4e, 0xea, 0x83,
+	0xca, 0x63, 0xf5, 0x3c, 0x5a, 0x17, 0xe9, 0xad, 0xab, 0x33, 0xd2, 0x56, 0xfc, 0xda, 0x2c, 0xdd,
+	0x78, 0x31, 0x12, 0x62, 0xe3, 0x91, 0x70, 0x9d, 0x5d, 0x77, 0xf1, 0x31, 0xd2, 0x33, 0x22, 0x74,
+	0xd6, 0xdf, 0x94, 0xe9, 0x5e, 0x93, 0x6d, 0xc1, 0x3a, 0xc8, 0x1e, 0x63, 0xac, 0xe2, 0x33, 0xdc,
+	0xb1, 0x3d, 0xb5, 0xe3, 0xb6, 0x68, 0x4d, 0x91, 0xda, 0x49, 0x6c, 0x2e, 0x94, 0x84, 0xf1, 0x48,
+	0xb8, 0xc6, 0x8e, 0xbe, 0x08, 0x25, 0xa1, 0x95, 0x63, 0x8c, 0x15, 0xba, 0x7a, 0xdf, 0x6d, 0x91,
+	0xfa, 0x73, 0xe1, 0x09, 0x58, 0x24, 0x58, 0xc3, 0x74, 0x69, 0x51, 0xb2, 0x52, 0x2b, 0x29, 0xc4,
+	0xd1, 0x1f, 0x46, 0xc2, 0xff, 0x5a, 0xa6, 0x47, 0x0a, 0x4b, 0xb7, 0x3a, 0x45, 0xdd, 0x72, 0x3b,
+	0x96, 0xeb, 0xff, 0xb9, 0xed, 0x1a, 0xa7, 0x45, 0x7a, 0x6a, 0xa1, 0x82, 0xf5, 0x69, 0xa9, 0x87,
+	0xcf, 0x92, 0x50, 0xfa, 0x18, 0xe3, 0x8a, 0x6f, 0xc1, 0x63, 0xb0, 0xd1, 0xd1, 0xce, 0xd4, 0x90,
+	0x67, 0xde, 0x99, 0xab, 0xda, 0xd8, 0x51, 0x8f, 0xda, 0x96, 0x7e, 0xca, 0xa7, 0x68, 0x36, 0xfe,
+	0x3f, 0x1e, 0x09, 0xff, 0x65, 0x67, 0xbd, 0x0d, 0x2d, 0xa1, 0xf5, 0x8e, 0x76, 0xb6, 0x13, 0x84,
+	0xd3, 0x3c, 0x73, 0xeb, 0xd8, 0x29, 0x91, 0x1d, 0xf8, 0x10, 0x5c, 0x0e, 0xb1, 0x48, 0x0f, 0xd0,
+	0x9a, 0x7f, 0x63, 0x9f, 0xd0, 0x7a, 0x5f, 0x9b, 0x91, 0x8d, 0x10, 0x25, 0xb4, 0x34, 0x51, 0x8c,
+	0x40, 0xb7, 0x93, 0xcf, 0x9e, 0x0b, 0x31, 0xe9, 0xb7, 0x04, 0x58, 0xaa, 0x87, 0xd3, 0x03, 0x97,
+	0x41, 0xdc, 0x64, 0xf5, 0x94, 0x44, 0x71, 0xd3, 0x80, 0x87, 0x20, 0x1d, 0x24, 0x75, 0x3a, 0x30,
+	0x36, 0x2e, 0x1a, 0x18, 0x34, 0xbb, 0x64, 0x5e, 0xac, 0x4d, 0xbb, 0x2e, 0x44, 0x95, 0x10, 0xd0,
+	0x26, 0x98, 0xb7, 0x4c, 0x8b, 0xc8, 0x10, 0x4b, 0xce, 0x0e, 0xb1, 0x7f, 0xc7, 0xd0, 0xc8, 0x81,
+	0x79, 0xdb, 0xb1, 0x6c, 0xcb, 0xc5, 0x8e, 0x3f, 0x35, 0x26, 0x36, 0xdc, 0x00, 0x0b, 0x9a, 0x6d,
+	0x3b, 0xd6, 0x23, 0xad, 0xed, 0xf2, 0x0b, 0xa4, 0x23, 0xd0, 0x74, 0x01, 0x7e, 0x02, 0xe6, 0x0d,
+	0xac, 0x19, 0x6d, 0xb3, 0x8b, 0x79, 0xf0, 0xa7, 0xe1, 0xcc, 0x93, 0x06, 0xa0, 0xce, 0x4f, 0x58,
+	0xd2, 0x8f, 0x1c, 0x48, 0xcb, 0x3d, 0xc3, 0xf4, 0x10, 0xd6, 0x2d, 0xc7, 0x98, 0x49, 0xfa, 0xc7,
+	0x60, 0xc1, 0xb2, 0x31, 0x6b, 0x75, 0x3f, 0xe5, 0xe2, 0x05, 0x29, 0x27, 0x27, 0xd4, 0x02, 0x1c,
+	0x9a, 0x52, 0x60, 0x16, 0xa4, 0x34, 0xdd, 0xb3, 0x1c, 0x3f, 0xb7, 0xcc, 0x80, 0x6b, 0x60, 0xce,
+	0xd3, 0x9c, 0x16, 0xf6, 0x5b, 0x13, 0xf9, 0x16, 0x59, 0xf7, 0x15, 0x24, 0x8d, 0x93, 0x40, 0xbe,
+	0x05, 0x3f, 0x00, 0xc9, 0x77, 0x4c, 0xd9, 0x34, 0x46, 0xca, 0x90, 0xbe, 0xe4, 0xc0, 0xd2, 0x8e,
+	0x63, 0x3d, 0xc6, 0x5d, 0xff, 0x1d, 0x0b, 0xd7, 0x1b, 0x77, 0xbe, 0xde, 0xee, 0x80, 0x85, 0x63,
+	0x0a, 0x25, 0xcf, 0x53, 0x9c, 0xce, 0x8c, 0xec, 0x78, 0x24, 0x64, 0xfc, 0x36, 0x0a, 0xb6, 0x24,
+	0x34, 0xcf, 0x7e, 0x97, 0xfa, 0xc4, 0x61, 0x07, 0x6b, 0xae, 0xd5, 0xf5, 0xe3, 0xf3, 0xad, 0x50,
+	0x20, 0xc9, 0x70, 0x20, 0xd2, 0xd7, 0x71, 0x90, 0x91, 0x0d, 0x83, 0x3e, 0xd2, 0x75, 0x9a, 0x63,
+	0xad, 0x4d, 0x34, 0xf2, 0x4c, 0xaf, 0x8d, 0x7d, 0x7f, 0x98, 0x11, 0xad, 0xfe, 0xf8, 0x6c, 0xf5,
+	0xbf, 0xb9, 0x73, 0xa2, 0x8f, 0x7b, 0xf2, 0xaf, 0x7b, 0xdc, 0xab, 0x60, 0xc5, 0x25, 0xde, 0xab,
+	0x61, 0xe7, 0x52, 0x54, 0xac, 0xd0, 0xa3, 0x33, 0x03, 0x91, 0x50, 0x86, 0xae, 0x55, 0x2e, 0xea,
+	0xde, 0xb9, 0x77, 0xe8, 0xde, 0xed, 0xc5, 0x27, 0xcf, 0x85, 0x18, 0x19, 0x55, 0xbf, 0x92, 0x71,
+	0xd5, 0x03, 0xab, 0x15, 0xdc, 0xc6, 0x1e, 0xfe, 0x9b, 0xa5, 0x8c, 0x5c, 0xfb, 0x0d, 0x07, 0xd6,
+	0xcb, 0x27, 0x5a, 0xb7, 0xc5, 0xee, 0x25, 0x8a, 0xfc, 0x23, 0xd3, 0x78, 0x3e, 0xac, 0x9b, 0x55,
+	0x90, 0x96, 0xcf, 0x7f, 0xc0, 0xed, 0x2a, 0x07, 0x4a, 0xa3, 0xda, 0xc8, 0xc4, 0x72, 0xe9, 0xc1,
+	0x50, 0xbc, 0xb4, 0xcb, 0x3e, 0x18, 0xc8, 0xa8, 0xaa, 0xa1, 0x4a, 0xf5, 0x40, 0x46, 0x0f, 0x32,
+	0x5c, 0x6e, 0x71, 0x30, 0x14, 0xe7, 0x6b, 0x8e, 0x61, 0x76, 0x35, 0xa7, 0x9f, 0x4b, 0x3e, 0xf9,
+	0x2a, 0x1f, 0xbb, 0xf9, 0x7d, 0x1c, 0x24, 0x49, 0xda, 0xe0, 0x0d, 0x90, 0x41, 0xb5, 0x7d, 0x45,
+	0x3d, 0x3c, 0x68, 0xd4, 0x95, 0x72, 0x75, 0xa7, 0xaa, 0x54, 0x32, 0xb1, 0xdc, 0xea, 0x60, 0x28,
+	0x5e, 0x26, 0xfb, 0x87, 0x5d, 0xd7, 0xc6, 0xba, 0x79, 0x6c, 0x62, 0x03, 0xbe, 0x07, 0xb2, 0x14,
+	0x5a, 0x43, 0x72, 0x99, 0xfc, 0xa9, 0x2b, 0x48, 0x6e, 0xd6, 0x50, 0x86, 0xcb, 0xad, 0x0d, 0x86,
+	0x22, 0x24, 0xf0, 0x9a, 0xa3, 0xe9, 0x6d, 0xcc, 0x46, 0x8c, 0xe5, 0xc0, 0x5b, 0x00, 0x52, 0x46,
+	0x43, 0x41, 0x9f, 0x56, 0xcb, 0x8a, 0x2a, 0x57, 0xee, 0x57, 0x0f, 0x32, 0xf1, 0x5c, 0x76, 0x30,
+	0x14, 0x33, 0x04, 0xdf, 0xc0, 0xce, 0x23, 0x53, 0xc7, 0xb2, 0xd1, 0x31, 0xbb, 0x70, 0xd3, 0x77,
+	0xa5, 0x59, 0xdb, 0x53, 0x0e, 0x7c, 0x6c, 0x22, 0x07, 0x07, 0x43, 0x71, 0x99, 0x60, 0x9b, 0xd6,
+	0x29, 0xee, 0x32, 0xe4, 0x16, 0xb8, 0xc2, 0x9c, 0xae, 0xef, 0x22, 0xb9, 0x12, 0x72, 0x25, 0x99,
+	0x5b, 0x1f, 0x0c, 0xc5, 0x55, 0xea, 0xb9, 0xdd, 0x72, 0x34, 0x63, 0xea, 0x4b, 0xe0, 0x7d, 0xb9,
+	0x8a, 0xca, 0x87, 0xd5, 0xa6, 0x5a, 0x42, 0x8a, 0xbc, 0xa7, 0xa0, 0x4c, 0x6a, 0xea, 0x7d, 0xd9,
+	0x74, 0xf4, 0x9e, 0xe9, 0x95, 0x1c, 0xac, 0x9d, 0xe2, 0x29, 0xa3, 0xb4, 0x5f, 0x2b, 0xef, 0xed,
+	0x57, 0x1b, 0x4d, 0xdf, 0xa7, 0xb9, 0x29, 0x83, 0x3e, 0xfb, 0x6d, 0xd3, 0xf5, 0xa8, 0x5f, 0x81,
+	0xb6, 0x1c, 0x00, 0xd3, 0xd7, 0x15, 0x6e, 0x81, 0x75, 0xb9, 0xdc, 0xac, 0xd6, 0x0e, 0xd4, 0xe6,
+	0x83, 0x7a, 0x54, 0xe8, 0x2b, 0x83, 0xa1, 0xb8, 0xc2, 0xc0, 0x61, 0xa9, 0x6f, 0x83, 0x2b, 0x61,
+	0x8e, 0x5c, 0xa9, 0xa8, 0x8d, 0xc3, 0xba, 0x42, 0xb4, 0xa6, 0x7a, 0x30, 0x46, 0x30, 0xa2, 0xe0,
+	0x5d, 0xc0, 0x87, 0xe1, 0x15, 0x65, 0x5f, 0x69, 0x2a, 0x3e, 0x23, 0x1e, 0xbe, 0x23, 0xd4, 0x8c,
+	0xd1, 0x3b, 0x1a, 0x4a, 0x53, 0x25, 0xe1, 0x36, 0x02, 0xcd, 0x19, 0xa3, 0x81, 0xe9, 0xe7, 0x87,
+	0xeb, 0xc7, 0xf6, 0x7b, 0x1c, 0x2c, 0x9f, 0x7f, 0x46, 0xe0, 0x87, 0xe0, 0x9a, 0x7c, 0x58, 0xa9,
+	0x36, 0xfd, 0x2c, 0x90, 0x03, 0xcf, 0xc7, 0xc8, 0x0f, 0x86, 0x62, 0x76, 0x82, 0x0f, 0x87, 0xf9,
+	0x3e, 0xb8, 0x1a, 0xa5, 0x86, 0x43, 0xa5, 0x8e, 0x4f, 0x88, 0x93, 0x68, 0xb7, 0xc1, 0x46, 0x94,
+	0x15, 0x89, 0xf8, 0xfc, 0x8d, 0xe1, 0xa0, 0x2f, 0xe0, 0x2a, 0x9f, 0xd7, 0xab, 0x28, 0xe0, 0x26,
+	0x22, 0x5c, 0x85, 0x7c, 0x01, 0xf8, 0xdc, 0x0b, 0xbc, 0x9d, 0x8a, 0x96, 0x8c, 0x78, 0x1b, 0xe8,
+	0x06, 0xcb, 0xe0, 0x3f, 0x51, 0x56, 0xf9, 0x9e, 0x7c, 0xb0, 0xeb, 0xdf, 0x48, 0xc5, 0xcf, 0xa4,
+	0x72, 0x1b, 0x83, 0xa1, 0xc8, 0x4f, 0xd8, 0x91, 0xe1, 0xc5, 0xc4, 0x2f, 0xed, 0xbd, 0x78, 0x95,
+	0xe7, 0x5e, 0xbe, 0xca, 0x73, 0x3f, 0xbf, 0xca, 0x73, 0x4f, 0x5f, 0xe7, 0x63, 0x2f, 0x5f, 0xe7,
+	0x63, 0xdf, 0xbd, 0xce, 0xc7, 0x1e, 0xde, 0x09, 0x7d, 0x2c, 0x93, 0xb1, 0xd3, 0xc5, 0x5e, 0xd1,
+	0x1f, 0x3f, 0xc5, 0x8e, 0x65, 0xf4, 0xda, 0xd8, 0x9d, 0xfc, 0xbb, 0xcb, 0xbe, 0x9d, 0x8f, 0xe6,
+	0xe8, 0xb3, 0x7c, 0xf7, 0x8f, 0x01, 0x00, 0x04, 0x71, 0xe8, 0x6a, 0x10, 0x0f, 0x00, 0x00,
+}
+
+func (m *Super) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Super) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Super) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiryTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGuardian(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Roles) > 0 {
+		dAtA3 := make([]byte, len(m.Roles)*10)
+		var j2 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGuardian(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AccountType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeExemptRole != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.FeeExemptRole))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxFeeExemptTxsPerBlock != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.MaxFeeExemptTxsPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.FeeDiscount.Size()
+		i -= size
+		if _, err := m.FeeDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGuardian(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.FeeExemptMsgTypes) > 0 {
+		for iNdEx := len(m.FeeExemptMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeExemptMsgTypes[iNdEx])
+			copy(dAtA[i:], m.FeeExemptMsgTypes[iNdEx])
+			i = encodeVarintGuardian(dAtA, i, uint64(len(m.FeeExemptMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PendingActionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PendingActionTimeout):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGuardian(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.GenesisThreshold != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.GenesisThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGuardian(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintGuardian(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiryTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGuardian(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Roles) > 0 {
+		dAtA8 := make([]byte, len(m.Roles)*10)
+		var j7 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintGuardian(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActionType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ActionType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGuardian(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x32
+	if m.Height != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Operation != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrozenAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FrozenBy) > 0 {
+		i -= len(m.FrozenBy)
+		copy(dAtA[i:], m.FrozenBy)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.FrozenBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddSuperProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddSuperProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddSuperProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		dAtA11 := make([]byte, len(m.Roles)*10)
+		var j10 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintGuardian(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SuperDescription) > 0 {
+		i -= len(m.SuperDescription)
+		copy(dAtA[i:], m.SuperDescription)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.SuperDescription)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AccountType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteSuperProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSuperProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSuperProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeSuperTypeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeSuperTypeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeSuperTypeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGuardian(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGuardian(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Super) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovGuardian(uint64(m.AccountType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovGuardian(uint64(e))
+		}
+		n += 1 + sovGuardian(uint64(l)) + l
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGuardian(uint64(m.ExpiryHeight))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GenesisThreshold != 0 {
+		n += 1 + sovGuardian(uint64(m.GenesisThreshold))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PendingActionTimeout)
+	n += 1 + l + sovGuardian(uint64(l))
+	if len(m.FeeExemptMsgTypes) > 0 {
+		for _, s := range m.FeeExemptMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	l = m.FeeDiscount.Size()
+	n += 1 + l + sovGuardian(uint64(l))
+	if m.MaxFeeExemptTxsPerBlock != 0 {
+		n += 1 + sovGuardian(uint64(m.MaxFeeExemptTxsPerBlock))
+	}
+	if m.FeeExemptRole != 0 {
+		n += 1 + sovGuardian(uint64(m.FeeExemptRole))
+	}
+	return n
+}
+
+func (m *PendingAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGuardian(uint64(m.Id))
+	}
+	if m.ActionType != 0 {
+		n += 1 + sovGuardian(uint64(m.ActionType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovGuardian(uint64(e))
+		}
+		n += 1 + sovGuardian(uint64(l)) + l
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGuardian(uint64(m.ExpiryHeight))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovGuardian(uint64(l))
+	return n
+}
+
+func (m *AuditRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGuardian(uint64(m.Id))
+	}
+	if m.Operation != 0 {
+		n += 1 + sovGuardian(uint64(m.Operation))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGuardian(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGuardian(uint64(l))
+	return n
+}
+
+func (m *FrozenAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.FrozenBy)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGuardian(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *AddSuperProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovGuardian(uint64(m.AccountType))
+	}
+	l = len(m.SuperDescription)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovGuardian(uint64(e))
+		}
+		n += 1 + sovGuardian(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *DeleteSuperProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *ChangeSuperTypeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovGuardian(uint64(m.AccountType))
+	}
+	return n
+}
+
+func sovGuardian(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGuardian(x uint64) (n int) {
+	return sovGuardian(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Super) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Super: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Super: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= AccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v Role
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGuardian
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Role(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGuardian
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGuardian
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGuardian
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]Role, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Role
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGuardian
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Role(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisThreshold", wireType)
+			}
+			m.GenesisThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActionTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PendingActionTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExemptMsgTypes = append(m.FeeExemptMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeExemptTxsPerBlock", wireType)
+			}
+			m.MaxFeeExemptTxsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFeeExemptTxsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptRole", wireType)
+			}
+			m.FeeExemptRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeExemptRole |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			m.ActionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionType |= ActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v Role
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGuardian
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Role(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGuardian
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGuardian
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGuardian
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]Role, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Role
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGuardian
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Role(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= AuditOperation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrozenAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddSuperProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddSuperProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddSuperProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= AccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuperDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v Role
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGuardian
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Role(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGuardian
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGuardian
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGuardian
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]Role, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Role
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGuardian
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Role(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGuardian(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGuardian(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Super) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
-	}
-	if m.AccountType != 0 {
-		n += 1 + sovGuardian(uint64(m.AccountType))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
-	}
-	l = len(m.AddedBy)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGuardian(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGuardian(x uint64) (n int) {
-	return sovGuardian(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Super) Unmarshal(dAtA []byte) error {
+func (m *DeleteSuperProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -265,15 +3322,15 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Super: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteSuperProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Super: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteSuperProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -301,13 +3358,13 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.AccountType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGuardian
@@ -317,11 +3374,24 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountType |= AccountType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
@@ -354,9 +3424,62 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeSuperTypeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeSuperTypeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeSuperTypeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -384,8 +3507,91 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= AccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGuardian(dAtA[iNdEx:])
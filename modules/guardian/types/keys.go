@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,6 +14,9 @@ const (
 	// StoreKey is the default store key for guardian
 	StoreKey = ModuleName
 
+	// TStoreKey is the transient store key for guardian, holding the fee exempt tx counts of the current block
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the message route for guardian
 	RouterKey = ModuleName
 
@@ -20,10 +25,72 @@ const (
 
 	// Query endpoints supported by the guardian querier
 	QuerySupers = "supers"
+	QuerySuper  = "super"
+
+	QueryPendingActions = "pending_actions"
+	QueryPendingAction  = "pending_action"
+	QueryParams         = "params"
+	QueryAuditLog       = "audit_log"
+
+	QueryDisabledMsgTypes = "disabled_msg_types"
+	QueryFrozenAccounts   = "frozen_accounts"
+	QueryFrozenAccount    = "frozen_account"
 )
 
+// QuerySupersParams defines the params for the legacy supers query
+type QuerySupersParams struct {
+	Role        Role   `json:"role"`
+	AccountType string `json:"account_type"`
+	AddedBy     string `json:"added_by"`
+	Page        int    `json:"page"`
+	Limit       int    `json:"limit"`
+}
+
+// QuerySuperParams defines the params for the legacy super query
+type QuerySuperParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// QueryPendingActionsParams defines the params for the legacy pending actions query
+type QueryPendingActionsParams struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+// QueryPendingActionParams defines the params for the legacy pending action query
+type QueryPendingActionParams struct {
+	ID uint64 `json:"id"`
+}
+
+// QueryAuditLogParams defines the params for the legacy audit log query
+type QueryAuditLogParams struct {
+	Target string `json:"target"`
+	Page   int    `json:"page"`
+	Limit  int    `json:"limit"`
+}
+
+// QueryFrozenAccountsParams defines the params for the legacy frozen accounts query
+type QueryFrozenAccountsParams struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+// QueryFrozenAccountParams defines the params for the legacy frozen account query
+type QueryFrozenAccountParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
 var (
-	SuperKey = []byte{0x00} // super key
+	SuperKey             = []byte{0x00} // super key
+	ExpiryHeightQueueKey = []byte{0x01} // key prefix for the supers expiring at a height
+	ExpiryTimeQueueKey   = []byte{0x02} // key prefix for the supers expiring at a time
+	PendingActionKey     = []byte{0x03} // key prefix for the pending actions
+	ActionQueueKey       = []byte{0x04} // key prefix for the pending actions dropped at a deadline
+	NextActionIDKey      = []byte{0x05} // key for the id of the next pending action
+	AuditLogKey          = []byte{0x06} // key prefix for the audit records
+	DisabledMsgTypeKey   = []byte{0x07} // key prefix for the disabled msg types
+	FrozenAccountKey     = []byte{0x08} // key prefix for the frozen accounts
+	FeeExemptionKey      = []byte{0x09} // key prefix for the fee exempt txs sent by the signers in a block
 )
 
 // GetSuperKey returns super key bytes
@@ -35,3 +102,58 @@ func GetSuperKey(addr sdk.AccAddress) []byte {
 func GetSupersSubspaceKey() []byte {
 	return SuperKey
 }
+
+// GetExpiryHeightQueueKey returns the key of the super expiring at the height
+func GetExpiryHeightQueueKey(height int64, addr sdk.AccAddress) []byte {
+	return append(GetExpiryHeightQueuePrefix(height), addr.Bytes()...)
+}
+
+// GetExpiryHeightQueuePrefix returns the key prefix of the supers expiring at the height
+func GetExpiryHeightQueuePrefix(height int64) []byte {
+	return append(ExpiryHeightQueueKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetExpiryTimeQueueKey returns the key of the super expiring at the time
+func GetExpiryTimeQueueKey(expiry time.Time, addr sdk.AccAddress) []byte {
+	return append(GetExpiryTimeQueuePrefix(expiry), addr.Bytes()...)
+}
+
+// GetExpiryTimeQueuePrefix returns the key prefix of the supers expiring at the time
+func GetExpiryTimeQueuePrefix(expiry time.Time) []byte {
+	return append(ExpiryTimeQueueKey, sdk.FormatTimeBytes(expiry)...)
+}
+
+// GetPendingActionKey returns the key of the pending action
+func GetPendingActionKey(id uint64) []byte {
+	return append(PendingActionKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetActionQueueKey returns the key of the pending action dropped at the deadline
+func GetActionQueueKey(deadline time.Time, id uint64) []byte {
+	return append(GetActionQueuePrefix(deadline), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetActionQueuePrefix returns the key prefix of the pending actions dropped at the deadline
+func GetActionQueuePrefix(deadline time.Time) []byte {
+	return append(ActionQueueKey, sdk.FormatTimeBytes(deadline)...)
+}
+
+// GetAuditRecordKey returns the key of the audit record
+func GetAuditRecordKey(id uint64) []byte {
+	return append(AuditLogKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetDisabledMsgTypeKey returns the key of the disabled msg type
+func GetDisabledMsgTypeKey(msgTypeURL string) []byte {
+	return append(DisabledMsgTypeKey, []byte(msgTypeURL)...)
+}
+
+// GetFrozenAccountKey returns the key of the frozen account
+func GetFrozenAccountKey(addr sdk.AccAddress) []byte {
+	return append(FrozenAccountKey, addr.Bytes()...)
+}
+
+// GetFeeExemptionKey returns the key of the fee exempt txs sent by the signer
+func GetFeeExemptionKey(addr sdk.AccAddress) []byte {
+	return append(FeeExemptionKey, addr.Bytes()...)
+}
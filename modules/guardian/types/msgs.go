@@ -1,29 +1,62 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
-	TypeMsgAddSuper    = "add_super"    // type for MsgAddSuper
-	TypeMsgDeleteSuper = "delete_super" // type for MsgDeleteSuper
+	TypeMsgAddSuper      = "add_super"      // type for MsgAddSuper
+	TypeMsgDeleteSuper   = "delete_super"   // type for MsgDeleteSuper
+	TypeMsgSetRoles      = "set_roles"      // type for MsgSetRoles
+	TypeMsgApproveAction = "approve_action" // type for MsgApproveAction
+	TypeMsgUpdateSuper   = "update_super"   // type for MsgUpdateSuper
+
+	TypeMsgDisableMsgTypes = "disable_msg_types" // type for MsgDisableMsgTypes
+	TypeMsgEnableMsgTypes  = "enable_msg_types"  // type for MsgEnableMsgTypes
+	TypeMsgFreezeAccount   = "freeze_account"    // type for MsgFreezeAccount
+	TypeMsgUnfreezeAccount = "unfreeze_account"  // type for MsgUnfreezeAccount
+
+	// DoNotModify is the value of the MsgUpdateSuper fields left unchanged
+	DoNotModify = "[do-not-modify]"
+
+	MaxDescriptionLength     = 70  // max length of the super description
+	MaxWebsiteLength         = 140 // max length of the super website
+	MaxSecurityContactLength = 140 // max length of the super security contact
+	MaxReasonLength          = 140 // max length of the reason to freeze an account
 )
 
 var (
 	_ sdk.Msg = &MsgAddSuper{}
 	_ sdk.Msg = &MsgDeleteSuper{}
+	_ sdk.Msg = &MsgSetRoles{}
+	_ sdk.Msg = &MsgApproveAction{}
+	_ sdk.Msg = &MsgUpdateSuper{}
+	_ sdk.Msg = &MsgDisableMsgTypes{}
+	_ sdk.Msg = &MsgEnableMsgTypes{}
+	_ sdk.Msg = &MsgFreezeAccount{}
+	_ sdk.Msg = &MsgUnfreezeAccount{}
 )
 
 // NewMsgAddSuper constructs a MsgAddSuper
-func NewMsgAddSuper(description string, address, addedBy sdk.AccAddress) *MsgAddSuper {
+func NewMsgAddSuper(description string, address, addedBy sdk.AccAddress, roles ...Role) *MsgAddSuper {
 	return &MsgAddSuper{
 		Description: description,
 		Address:     address.String(),
 		AddedBy:     addedBy.String(),
+		Roles:       roles,
 	}
 }
 
+// WithExpiry returns the msg adding a super expiring at the time or height, whichever comes first
+func (msg *MsgAddSuper) WithExpiry(expiryTime *time.Time, expiryHeight int64) *MsgAddSuper {
+	msg.ExpiryTime = expiryTime
+	msg.ExpiryHeight = expiryHeight
+	return msg
+}
+
 // Route implements Msg.
 func (msg MsgAddSuper) Route() string { return RouterKey }
 
@@ -50,6 +83,12 @@ func (msg MsgAddSuper) ValidateBasic() error {
 	if len(msg.AddedBy) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "operator address missing")
 	}
+	if err := ValidateRoles(msg.Roles); err != nil {
+		return err
+	}
+	if err := ValidateExpiry(msg.ExpiryTime, msg.ExpiryHeight); err != nil {
+		return err
+	}
 	if err := msg.EnsureLength(); err != nil {
 		return err
 	}
@@ -110,10 +149,341 @@ func (msg MsgDeleteSuper) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// ______________________________________________________________________
+
+// NewMsgSetRoles constructs a MsgSetRoles
+func NewMsgSetRoles(address, setBy sdk.AccAddress, roles ...Role) *MsgSetRoles {
+	return &MsgSetRoles{
+		Address: address.String(),
+		Roles:   roles,
+		SetBy:   setBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgSetRoles) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSetRoles) Type() string { return TypeMsgSetRoles }
+
+// GetSignBytes implements Msg.
+func (msg MsgSetRoles) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgSetRoles) ValidateBasic() error {
+	if len(msg.Address) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "super address missing")
+	}
+	if len(msg.SetBy) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "operator address missing")
+	}
+	return ValidateRoles(msg.Roles)
+}
+
+// GetSigners implements Msg.
+func (msg MsgSetRoles) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.SetBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgApproveAction constructs a MsgApproveAction
+func NewMsgApproveAction(id uint64, approver sdk.AccAddress) *MsgApproveAction {
+	return &MsgApproveAction{
+		Id:       id,
+		Approver: approver.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgApproveAction) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgApproveAction) Type() string { return TypeMsgApproveAction }
+
+// GetSignBytes implements Msg.
+func (msg MsgApproveAction) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgApproveAction) ValidateBasic() error {
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrUnknownAction, "action id missing")
+	}
+	if len(msg.Approver) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "approver address missing")
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgApproveAction) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Approver)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgUpdateSuper constructs a MsgUpdateSuper, pass DoNotModify to leave a field unchanged
+func NewMsgUpdateSuper(address sdk.AccAddress, description, website, securityContact string) *MsgUpdateSuper {
+	return &MsgUpdateSuper{
+		Address:         address.String(),
+		Description:     description,
+		Website:         website,
+		SecurityContact: securityContact,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgUpdateSuper) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgUpdateSuper) Type() string { return TypeMsgUpdateSuper }
+
+// GetSignBytes implements Msg.
+func (msg MsgUpdateSuper) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgUpdateSuper) ValidateBasic() error {
+	if len(msg.Address) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "super address missing")
+	}
+	// the description can be changed but not cleared, as required on adding the super
+	if len(msg.Description) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "description missing")
+	}
+	if msg.Description == DoNotModify && msg.Website == DoNotModify && msg.SecurityContact == DoNotModify {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nothing to update")
+	}
+	return msg.EnsureLength()
+}
+
+// GetSigners implements Msg.
+func (msg MsgUpdateSuper) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// EnsureLength validate the length of MsgUpdateSuper
+func (msg MsgUpdateSuper) EnsureLength() error {
+	if len(msg.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid description length; got: %d, max: %d", len(msg.Description), MaxDescriptionLength)
+	}
+	if len(msg.Website) > MaxWebsiteLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid website length; got: %d, max: %d", len(msg.Website), MaxWebsiteLength)
+	}
+	if len(msg.SecurityContact) > MaxSecurityContactLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid security contact length; got: %d, max: %d", len(msg.SecurityContact), MaxSecurityContactLength)
+	}
+	return nil
+}
+
 // EnsureLength validate the length of AddGuardian
 func (msg MsgAddSuper) EnsureLength() error {
-	if len(msg.Description) > 70 {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid website length; got: %d, max: %d", len(msg.Description), 70)
+	if len(msg.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid description length; got: %d, max: %d", len(msg.Description), MaxDescriptionLength)
+	}
+	return nil
+}
+
+// NewMsgDisableMsgTypes constructs a MsgDisableMsgTypes
+func NewMsgDisableMsgTypes(msgTypeURLs []string, operator sdk.AccAddress) *MsgDisableMsgTypes {
+	return &MsgDisableMsgTypes{
+		MsgTypeUrls: msgTypeURLs,
+		Operator:    operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgDisableMsgTypes) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgDisableMsgTypes) Type() string { return TypeMsgDisableMsgTypes }
+
+// GetSignBytes implements Msg.
+func (msg MsgDisableMsgTypes) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgDisableMsgTypes) ValidateBasic() error {
+	if len(msg.Operator) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "operator address missing")
+	}
+	if len(msg.MsgTypeUrls) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgTypeURL, "msg type urls missing")
+	}
+	return ValidateMsgTypeURLs(msg.MsgTypeUrls)
+}
+
+// GetSigners implements Msg.
+func (msg MsgDisableMsgTypes) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgEnableMsgTypes constructs a MsgEnableMsgTypes
+func NewMsgEnableMsgTypes(msgTypeURLs []string, operator sdk.AccAddress) *MsgEnableMsgTypes {
+	return &MsgEnableMsgTypes{
+		MsgTypeUrls: msgTypeURLs,
+		Operator:    operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgEnableMsgTypes) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgEnableMsgTypes) Type() string { return TypeMsgEnableMsgTypes }
+
+// GetSignBytes implements Msg.
+func (msg MsgEnableMsgTypes) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgEnableMsgTypes) ValidateBasic() error {
+	if len(msg.Operator) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "operator address missing")
+	}
+	if len(msg.MsgTypeUrls) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgTypeURL, "msg type urls missing")
+	}
+	return ValidateMsgTypeURLs(msg.MsgTypeUrls)
+}
+
+// GetSigners implements Msg.
+func (msg MsgEnableMsgTypes) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgFreezeAccount constructs a MsgFreezeAccount
+func NewMsgFreezeAccount(address sdk.AccAddress, reason string, operator sdk.AccAddress) *MsgFreezeAccount {
+	return &MsgFreezeAccount{
+		Address:  address.String(),
+		Reason:   reason,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgFreezeAccount) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgFreezeAccount) Type() string { return TypeMsgFreezeAccount }
+
+// GetSignBytes implements Msg.
+func (msg MsgFreezeAccount) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgFreezeAccount) ValidateBasic() error {
+	if len(msg.Address) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "frozen address missing")
+	}
+	if len(msg.Operator) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "operator address missing")
+	}
+	if msg.Address == msg.Operator {
+		return sdkerrors.Wrap(ErrFreezeSuper, "operator can't freeze itself")
+	}
+	if len(msg.Reason) > MaxReasonLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid reason length; got: %d, max: %d", len(msg.Reason), MaxReasonLength)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgUnfreezeAccount constructs a MsgUnfreezeAccount
+func NewMsgUnfreezeAccount(address, operator sdk.AccAddress) *MsgUnfreezeAccount {
+	return &MsgUnfreezeAccount{
+		Address:  address.String(),
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgUnfreezeAccount) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgUnfreezeAccount) Type() string { return TypeMsgUnfreezeAccount }
+
+// GetSignBytes implements Msg.
+func (msg MsgUnfreezeAccount) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgUnfreezeAccount) ValidateBasic() error {
+	if len(msg.Address) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "frozen address missing")
+	}
+	if len(msg.Operator) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "operator address missing")
 	}
 	return nil
 }
+
+// GetSigners implements Msg.
+func (msg MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -73,6 +74,10 @@ func TestMsgAddSuperValidation(t *testing.T) {
 		{"invalid Description", false, NewMsgAddSuper(nilDescription, testAddr, sender)},
 		{"invalid Address", false, NewMsgAddSuper(description, nilAddr, sender)},
 		{"invalid AddedBy", false, NewMsgAddSuper(description, testAddr, nilAddr)},
+		{"pass with roles", true, NewMsgAddSuper(description, testAddr, sender, RoleOracleOperator, RoleUpgradeOperator)},
+		{"invalid Roles", false, NewMsgAddSuper(description, testAddr, sender, RoleOracleOperator, RoleUnspecified)},
+		{"pass with expiry", true, NewMsgAddSuper(description, testAddr, sender).WithExpiry(nil, 100)},
+		{"invalid expiry", false, NewMsgAddSuper(description, testAddr, sender).WithExpiry(nil, -1)},
 	}
 
 	for _, tc := range tests {
@@ -144,3 +149,238 @@ func TestMsgDeleteSuperValidation(t *testing.T) {
 		})
 	}
 }
+
+// ----------------------------------------------
+// test MsgSetRoles
+// ----------------------------------------------
+
+func TestNewMsgSetRoles(t *testing.T) {
+	msg := NewMsgSetRoles(testAddr, sender, RoleOracleOperator)
+	require.Equal(t, testAddr.String(), msg.Address)
+	require.Equal(t, sender.String(), msg.SetBy)
+	require.Equal(t, []Role{RoleOracleOperator}, msg.Roles)
+}
+
+func TestMsgSetRolesRoute(t *testing.T) {
+	msg := NewMsgSetRoles(testAddr, sender, RoleOracleOperator)
+	require.Equal(t, RouterKey, msg.Route())
+}
+
+func TestMsgSetRolesType(t *testing.T) {
+	msg := NewMsgSetRoles(testAddr, sender, RoleOracleOperator)
+	require.Equal(t, TypeMsgSetRoles, msg.Type())
+}
+
+func TestMsgSetRolesGetSignBytes(t *testing.T) {
+	msg := NewMsgSetRoles(testAddr, sender, RoleOracleOperator, RoleTokenAdmin)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgSetRoles","value":{"address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","roles":[1,3],"set_by":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgSetRolesGetSigners(t *testing.T) {
+	msg := NewMsgSetRoles(testAddr, sender, RoleOracleOperator)
+	res := msg.GetSigners()
+	expected := "[0A367B92CF0B037DFD89960EE832D56F7FC15168]"
+	require.Equal(t, expected, fmt.Sprintf("%v", res))
+}
+
+// test ValidateBasic for MsgSetRoles
+func TestMsgSetRolesValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgSetRoles
+	}{
+		{"pass", true, NewMsgSetRoles(testAddr, sender, RoleOracleOperator, RoleServiceAdmin)},
+		{"revoke all roles", true, NewMsgSetRoles(testAddr, sender)},
+		{"invalid Address", false, NewMsgSetRoles(nilAddr, sender, RoleOracleOperator)},
+		{"invalid SetBy", false, NewMsgSetRoles(testAddr, nilAddr, RoleOracleOperator)},
+		{"unspecified role", false, NewMsgSetRoles(testAddr, sender, RoleUnspecified)},
+		{"unknown role", false, NewMsgSetRoles(testAddr, sender, Role(10))},
+		{"duplicated role", false, NewMsgSetRoles(testAddr, sender, RoleTokenAdmin, RoleTokenAdmin)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+// test MsgApproveAction
+// ----------------------------------------------
+
+func TestMsgApproveActionGetSignBytes(t *testing.T) {
+	msg := NewMsgApproveAction(1, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgApproveAction","value":{"approver":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf","id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+// test ValidateBasic for MsgApproveAction
+func TestMsgApproveActionValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgApproveAction
+	}{
+		{"pass", true, NewMsgApproveAction(1, sender)},
+		{"missing id", false, NewMsgApproveAction(0, sender)},
+		{"invalid Approver", false, NewMsgApproveAction(1, nilAddr)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+// test MsgUpdateSuper
+// ----------------------------------------------
+
+func TestMsgUpdateSuperGetSignBytes(t *testing.T) {
+	msg := NewMsgUpdateSuper(testAddr, "description", "https://irisnet.org", DoNotModify)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgUpdateSuper","value":{"address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","description":"description","security_contact":"[do-not-modify]","website":"https://irisnet.org"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgUpdateSuperGetSigners(t *testing.T) {
+	msg := NewMsgUpdateSuper(sender, "description", DoNotModify, DoNotModify)
+	res := msg.GetSigners()
+	expected := "[0A367B92CF0B037DFD89960EE832D56F7FC15168]"
+	require.Equal(t, expected, fmt.Sprintf("%v", res))
+}
+
+// test ValidateBasic for MsgUpdateSuper
+func TestMsgUpdateSuperValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgUpdateSuper
+	}{
+		{"pass", true, NewMsgUpdateSuper(testAddr, "description", "https://irisnet.org", "security@irisnet.org")},
+		{"clear the website", true, NewMsgUpdateSuper(testAddr, DoNotModify, "", DoNotModify)},
+		{"invalid Address", false, NewMsgUpdateSuper(nilAddr, "description", DoNotModify, DoNotModify)},
+		{"nothing to update", false, NewMsgUpdateSuper(testAddr, DoNotModify, DoNotModify, DoNotModify)},
+		{"empty description", false, NewMsgUpdateSuper(testAddr, "", DoNotModify, DoNotModify)},
+		{"description too long", false, NewMsgUpdateSuper(testAddr, strings.Repeat("d", MaxDescriptionLength+1), DoNotModify, DoNotModify)},
+		{"website too long", false, NewMsgUpdateSuper(testAddr, DoNotModify, strings.Repeat("w", MaxWebsiteLength+1), DoNotModify)},
+		{"security contact too long", false, NewMsgUpdateSuper(testAddr, DoNotModify, DoNotModify, strings.Repeat("s", MaxSecurityContactLength+1))},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+// test MsgDisableMsgTypes and MsgEnableMsgTypes
+// ----------------------------------------------
+
+func TestMsgDisableMsgTypesGetSignBytes(t *testing.T) {
+	msg := NewMsgDisableMsgTypes([]string{"/irismod.token.MsgMintToken"}, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgDisableMsgTypes","value":{"msg_type_urls":["/irismod.token.MsgMintToken"],"operator":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf"}}`
+	require.Equal(t, expected, string(res))
+}
+
+// test ValidateBasic for MsgDisableMsgTypes and MsgEnableMsgTypes
+func TestMsgDisableMsgTypesValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		expectPass  bool
+		msgTypeURLs []string
+		operator    sdk.AccAddress
+	}{
+		{"pass", true, []string{"/irismod.token.MsgMintToken", "/cosmos.bank.v1beta1.MsgSend"}, sender},
+		{"missing msg type urls", false, nil, sender},
+		{"missing leading slash", false, []string{"irismod.token.MsgMintToken"}, sender},
+		{"invalid character", false, []string{"/irismod.token.MsgMintToken "}, sender},
+		{"duplicated msg type url", false, []string{"/irismod.token.MsgMintToken", "/irismod.token.MsgMintToken"}, sender},
+		{"circuit breaker msg type", false, []string{MsgTypeURL(&MsgEnableMsgTypes{})}, sender},
+		{"invalid operator", false, []string{"/irismod.token.MsgMintToken"}, nilAddr},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, msg := range []sdk.Msg{
+				NewMsgDisableMsgTypes(tc.msgTypeURLs, tc.operator),
+				NewMsgEnableMsgTypes(tc.msgTypeURLs, tc.operator),
+			} {
+				err := msg.ValidateBasic()
+				if tc.expectPass {
+					require.NoError(t, err)
+				} else {
+					require.Error(t, err)
+				}
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+// test MsgFreezeAccount and MsgUnfreezeAccount
+// ----------------------------------------------
+
+func TestMsgFreezeAccountGetSignBytes(t *testing.T) {
+	msg := NewMsgFreezeAccount(testAddr, "stolen key", sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgFreezeAccount","value":{"address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","operator":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf","reason":"stolen key"}}`
+	require.Equal(t, expected, string(res))
+}
+
+// test ValidateBasic for MsgFreezeAccount
+func TestMsgFreezeAccountValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgFreezeAccount
+	}{
+		{"pass", true, NewMsgFreezeAccount(testAddr, "stolen key", sender)},
+		{"pass without reason", true, NewMsgFreezeAccount(testAddr, "", sender)},
+		{"missing address", false, NewMsgFreezeAccount(nilAddr, "stolen key", sender)},
+		{"missing operator", false, NewMsgFreezeAccount(testAddr, "stolen key", nilAddr)},
+		{"freeze the operator", false, NewMsgFreezeAccount(sender, "stolen key", sender)},
+		{"too long reason", false, NewMsgFreezeAccount(testAddr, strings.Repeat("r", MaxReasonLength+1), sender)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// test ValidateBasic for MsgUnfreezeAccount
+func TestMsgUnfreezeAccountValidation(t *testing.T) {
+	require.NoError(t, NewMsgUnfreezeAccount(testAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgUnfreezeAccount(nilAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgUnfreezeAccount(testAddr, nilAddr).ValidateBasic())
+}
@@ -0,0 +1,183 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store key
+var (
+	KeyGenesisThreshold     = []byte("GenesisThreshold")
+	KeyPendingActionTimeout = []byte("PendingActionTimeout")
+
+	KeyFeeExemptMsgTypes       = []byte("FeeExemptMsgTypes")
+	KeyFeeDiscount             = []byte("FeeDiscount")
+	KeyMaxFeeExemptTxsPerBlock = []byte("MaxFeeExemptTxsPerBlock")
+	KeyFeeExemptRole           = []byte("FeeExemptRole")
+)
+
+// ParamKeyTable for guardian module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	genesisThreshold uint32, pendingActionTimeout time.Duration,
+	feeExemptMsgTypes []string, feeDiscount sdk.Dec, maxFeeExemptTxsPerBlock uint32, feeExemptRole Role,
+) Params {
+	return Params{
+		GenesisThreshold:        genesisThreshold,
+		PendingActionTimeout:    pendingActionTimeout,
+		FeeExemptMsgTypes:       feeExemptMsgTypes,
+		FeeDiscount:             feeDiscount,
+		MaxFeeExemptTxsPerBlock: maxFeeExemptTxsPerBlock,
+		FeeExemptRole:           feeExemptRole,
+	}
+}
+
+// DefaultParams returns default guardian module parameters,
+// a single genesis super is enough to add or delete a super,
+// no msg type is fee exempt until allow-listed by governance,
+// and then only for the oracle operators
+func DefaultParams() Params {
+	return NewParams(1, 24*time.Hour, nil, sdk.OneDec(), 10, RoleOracleOperator)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyGenesisThreshold, &p.GenesisThreshold, validateGenesisThreshold),
+		paramtypes.NewParamSetPair(KeyPendingActionTimeout, &p.PendingActionTimeout, validatePendingActionTimeout),
+		paramtypes.NewParamSetPair(KeyFeeExemptMsgTypes, &p.FeeExemptMsgTypes, validateFeeExemptMsgTypes),
+		paramtypes.NewParamSetPair(KeyFeeDiscount, &p.FeeDiscount, validateFeeDiscount),
+		paramtypes.NewParamSetPair(KeyMaxFeeExemptTxsPerBlock, &p.MaxFeeExemptTxsPerBlock, validateMaxFeeExemptTxsPerBlock),
+		paramtypes.NewParamSetPair(KeyFeeExemptRole, &p.FeeExemptRole, validateFeeExemptRole),
+	}
+}
+
+// IsFeeExemptMsgType returns true if the msg type is allow-listed for the fee exemption
+func (p Params) IsFeeExemptMsgType(msgTypeURL string) bool {
+	for _, t := range p.FeeExemptMsgTypes {
+		if t == msgTypeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateGenesisThreshold(p.GenesisThreshold); err != nil {
+		return err
+	}
+	if err := validatePendingActionTimeout(p.PendingActionTimeout); err != nil {
+		return err
+	}
+	if err := validateFeeExemptMsgTypes(p.FeeExemptMsgTypes); err != nil {
+		return err
+	}
+	if err := validateFeeDiscount(p.FeeDiscount); err != nil {
+		return err
+	}
+	if err := validateMaxFeeExemptTxsPerBlock(p.MaxFeeExemptTxsPerBlock); err != nil {
+		return err
+	}
+	return validateFeeExemptRole(p.FeeExemptRole)
+}
+
+func validateGenesisThreshold(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("genesis threshold must be positive")
+	}
+
+	return nil
+}
+
+func validatePendingActionTimeout(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("pending action timeout [%s] must be positive", v)
+	}
+
+	return nil
+}
+
+func validateFeeExemptMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, msgTypeURL := range v {
+		if err := validateMsgTypeURLFormat(msgTypeURL); err != nil {
+			return err
+		}
+		if seen[msgTypeURL] {
+			return fmt.Errorf("duplicated fee exempt msg type %s", msgTypeURL)
+		}
+		seen[msgTypeURL] = true
+	}
+
+	return nil
+}
+
+func validateFeeDiscount(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee discount [%s] must be between 0 and 1", v)
+	}
+
+	return nil
+}
+
+func validateMaxFeeExemptTxsPerBlock(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeExemptRole(i interface{}) error {
+	v, ok := i.(Role)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !ValidRole(v) {
+		return fmt.Errorf("invalid fee exempt role %d", v)
+	}
+
+	return nil
+}
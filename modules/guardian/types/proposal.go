@@ -0,0 +1,183 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeAddSuper defines the type for a AddSuperProposal
+	ProposalTypeAddSuper = "AddSuper"
+	// ProposalTypeDeleteSuper defines the type for a DeleteSuperProposal
+	ProposalTypeDeleteSuper = "DeleteSuper"
+	// ProposalTypeChangeSuperType defines the type for a ChangeSuperTypeProposal
+	ProposalTypeChangeSuperType = "ChangeSuperType"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &AddSuperProposal{}
+	_ govtypes.Content = &DeleteSuperProposal{}
+	_ govtypes.Content = &ChangeSuperTypeProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddSuper)
+	govtypes.RegisterProposalTypeCodec(&AddSuperProposal{}, "irishub/guardian/AddSuperProposal")
+	govtypes.RegisterProposalType(ProposalTypeDeleteSuper)
+	govtypes.RegisterProposalTypeCodec(&DeleteSuperProposal{}, "irishub/guardian/DeleteSuperProposal")
+	govtypes.RegisterProposalType(ProposalTypeChangeSuperType)
+	govtypes.RegisterProposalTypeCodec(&ChangeSuperTypeProposal{}, "irishub/guardian/ChangeSuperTypeProposal")
+}
+
+// NewAddSuperProposal creates a new proposal to add a super
+func NewAddSuperProposal(
+	title, description string,
+	address sdk.AccAddress,
+	accountType AccountType,
+	superDescription string,
+	roles ...Role,
+) *AddSuperProposal {
+	return &AddSuperProposal{
+		Title:            title,
+		Description:      description,
+		Address:          address.String(),
+		AccountType:      accountType,
+		SuperDescription: superDescription,
+		Roles:            roles,
+	}
+}
+
+// GetTitle returns the title of an add super proposal.
+func (p *AddSuperProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add super proposal.
+func (p *AddSuperProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add super proposal.
+func (p *AddSuperProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add super proposal.
+func (p *AddSuperProposal) ProposalType() string { return ProposalTypeAddSuper }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddSuperProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !ValidAccountType(p.AccountType) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type: %d", p.AccountType)
+	}
+	if len(p.SuperDescription) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "super description missing")
+	}
+	if len(p.SuperDescription) > 70 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid super description length; got: %d, max: %d", len(p.SuperDescription), 70)
+	}
+	return ValidateRoles(p.Roles)
+}
+
+// String implements the Stringer interface.
+func (p AddSuperProposal) String() string {
+	return fmt.Sprintf(`Add Super Proposal:
+  Title:             %s
+  Description:       %s
+  Address:           %s
+  Account Type:      %s
+  Super Description: %s
+  Roles:             %s
+`, p.Title, p.Description, p.Address, p.AccountType, p.SuperDescription, RolesString(p.Roles))
+}
+
+// NewDeleteSuperProposal creates a new proposal to delete a super
+func NewDeleteSuperProposal(title, description string, address sdk.AccAddress) *DeleteSuperProposal {
+	return &DeleteSuperProposal{
+		Title:       title,
+		Description: description,
+		Address:     address.String(),
+	}
+}
+
+// GetTitle returns the title of a delete super proposal.
+func (p *DeleteSuperProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a delete super proposal.
+func (p *DeleteSuperProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a delete super proposal.
+func (p *DeleteSuperProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a delete super proposal.
+func (p *DeleteSuperProposal) ProposalType() string { return ProposalTypeDeleteSuper }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *DeleteSuperProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p DeleteSuperProposal) String() string {
+	return fmt.Sprintf(`Delete Super Proposal:
+  Title:       %s
+  Description: %s
+  Address:     %s
+`, p.Title, p.Description, p.Address)
+}
+
+// NewChangeSuperTypeProposal creates a new proposal to change the account type of a super
+func NewChangeSuperTypeProposal(title, description string, address sdk.AccAddress, accountType AccountType) *ChangeSuperTypeProposal {
+	return &ChangeSuperTypeProposal{
+		Title:       title,
+		Description: description,
+		Address:     address.String(),
+		AccountType: accountType,
+	}
+}
+
+// GetTitle returns the title of a change super type proposal.
+func (p *ChangeSuperTypeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a change super type proposal.
+func (p *ChangeSuperTypeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a change super type proposal.
+func (p *ChangeSuperTypeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a change super type proposal.
+func (p *ChangeSuperTypeProposal) ProposalType() string { return ProposalTypeChangeSuperType }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ChangeSuperTypeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !ValidAccountType(p.AccountType) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type: %d", p.AccountType)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ChangeSuperTypeProposal) String() string {
+	return fmt.Sprintf(`Change Super Type Proposal:
+  Title:        %s
+  Description:  %s
+  Address:      %s
+  Account Type: %s
+`, p.Title, p.Description, p.Address, p.AccountType)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddSuperProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		proposal *AddSuperProposal
+		expPass  bool
+	}{
+		{"genesis", NewAddSuperProposal("title", "description", testAddr, Genesis, description), true},
+		{"ordinary with roles", NewAddSuperProposal("title", "description", testAddr, Ordinary, description, RoleOracleOperator), true},
+		{"empty title", NewAddSuperProposal("", "description", testAddr, Ordinary, description), false},
+		{"empty address", NewAddSuperProposal("title", "description", nilAddr, Ordinary, description), false},
+		{"invalid account type", NewAddSuperProposal("title", "description", testAddr, AccountType(5), description), false},
+		{"empty super description", NewAddSuperProposal("title", "description", testAddr, Ordinary, nilDescription), false},
+		{"invalid roles", NewAddSuperProposal("title", "description", testAddr, Ordinary, description, RoleUnspecified), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestDeleteSuperProposalValidateBasic(t *testing.T) {
+	require.NoError(t, NewDeleteSuperProposal("title", "description", testAddr).ValidateBasic())
+	require.Error(t, NewDeleteSuperProposal("title", "", testAddr).ValidateBasic())
+	require.Error(t, NewDeleteSuperProposal("title", "description", nilAddr).ValidateBasic())
+}
+
+func TestChangeSuperTypeProposalValidateBasic(t *testing.T) {
+	require.NoError(t, NewChangeSuperTypeProposal("title", "description", testAddr, Genesis).ValidateBasic())
+	require.NoError(t, NewChangeSuperTypeProposal("title", "description", testAddr, Ordinary).ValidateBasic())
+	require.Error(t, NewChangeSuperTypeProposal("title", "description", nilAddr, Ordinary).ValidateBasic())
+	require.Error(t, NewChangeSuperTypeProposal("title", "description", testAddr, AccountType(5)).ValidateBasic())
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -31,6 +32,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // QuerySupersRequest is request type for the Query/Supers RPC method
 type QuerySupersRequest struct {
+	// supers not granted the role are skipped, if specified
+	Role Role `protobuf:"varint,1,opt,name=role,proto3,enum=irishub.guardian.Role" json:"role,omitempty"`
+	// supers not of the account type (Genesis or Ordinary) are skipped, if not empty
+	AccountType string `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty" yaml:"account_type"`
+	// supers not added by the address are skipped, if not empty
+	AddedBy    string             `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty" yaml:"added_by"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QuerySupersRequest) Reset()         { *m = QuerySupersRequest{} }
@@ -66,9 +74,38 @@ func (m *QuerySupersRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySupersRequest proto.InternalMessageInfo
 
+func (m *QuerySupersRequest) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return RoleUnspecified
+}
+
+func (m *QuerySupersRequest) GetAccountType() string {
+	if m != nil {
+		return m.AccountType
+	}
+	return ""
+}
+
+func (m *QuerySupersRequest) GetAddedBy() string {
+	if m != nil {
+		return m.AddedBy
+	}
+	return ""
+}
+
+func (m *QuerySupersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QuerySupersResponse is response type for the Query/Supers RPC method
 type QuerySupersResponse struct {
-	Supers []Super `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
+	Supers     []Super             `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QuerySupersResponse) Reset()         { *m = QuerySupersResponse{} }
@@ -111,219 +148,3765 @@ func (m *QuerySupersResponse) GetSupers() []Super {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QuerySupersRequest)(nil), "irishub.guardian.QuerySupersRequest")
-	proto.RegisterType((*QuerySupersResponse)(nil), "irishub.guardian.QuerySupersResponse")
+func (m *QuerySupersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("guardian/query.proto", fileDescriptor_20cf24f8e5be2110) }
+// QuerySuperRequest is request type for the Query/Super RPC method
+type QuerySuperRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
 
-var fileDescriptor_20cf24f8e5be2110 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x49, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0xc8, 0x2c, 0xca, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x83, 0xc9, 0x4a, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x25, 0xf5, 0x41, 0x2c, 0x88, 0x3a, 0x29, 0x71, 0xb8, 0x6e, 0x18, 0x03, 0x2a,
-	0x21, 0x93, 0x9e, 0x9f, 0x9f, 0x9e, 0x93, 0xaa, 0x9f, 0x58, 0x90, 0xa9, 0x9f, 0x98, 0x97, 0x97,
-	0x5f, 0x92, 0x58, 0x92, 0x99, 0x9f, 0x57, 0x0c, 0x91, 0x55, 0x12, 0xe1, 0x12, 0x0a, 0x04, 0xd9,
-	0x16, 0x5c, 0x5a, 0x90, 0x5a, 0x54, 0x1c, 0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0xa2, 0xe4, 0xc3,
-	0x25, 0x8c, 0x22, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x2a, 0x64, 0xca, 0xc5, 0x56, 0x0c, 0x16,
-	0x91, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x12, 0xd7, 0x43, 0x77, 0x9c, 0x1e, 0x58, 0x87, 0x13,
-	0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xc5, 0x46, 0x0d, 0x8c, 0x5c, 0xac, 0x60, 0xe3, 0x84,
-	0xca, 0xb9, 0xd8, 0x20, 0x46, 0x0a, 0xa9, 0x60, 0x6a, 0xc5, 0x74, 0x87, 0x94, 0x2a, 0x01, 0x55,
-	0x10, 0x77, 0x29, 0x29, 0x34, 0x5d, 0x7e, 0x32, 0x99, 0x49, 0x4a, 0x48, 0x42, 0x1f, 0xaa, 0x1c,
-	0x1e, 0x06, 0xfa, 0x10, 0x27, 0x38, 0x79, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
-	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
-	0x43, 0x94, 0x61, 0x7a, 0x66, 0x09, 0xc8, 0x82, 0xe4, 0xfc, 0x5c, 0xb0, 0xee, 0xbc, 0xd4, 0x12,
-	0xb8, 0x29, 0xb9, 0xf9, 0x29, 0xa5, 0x39, 0xa9, 0xc5, 0x08, 0xd3, 0x4a, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x41, 0x67, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x45, 0x27, 0x9c, 0x0d, 0xb1,
-	0x01, 0x00, 0x00,
+func (m *QuerySuperRequest) Reset()         { *m = QuerySuperRequest{} }
+func (m *QuerySuperRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySuperRequest) ProtoMessage()    {}
+func (*QuerySuperRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{2}
+}
+func (m *QuerySuperRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuperRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuperRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuperRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuperRequest.Merge(m, src)
+}
+func (m *QuerySuperRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuperRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuperRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QuerySuperRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QuerySuperRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Supers returns all Supers
-	Supers(ctx context.Context, in *QuerySupersRequest, opts ...grpc.CallOption) (*QuerySupersResponse, error)
+// QuerySuperResponse is response type for the Query/Super RPC method
+type QuerySuperResponse struct {
+	Super Super `protobuf:"bytes,1,opt,name=super,proto3" json:"super"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySuperResponse) Reset()         { *m = QuerySuperResponse{} }
+func (m *QuerySuperResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySuperResponse) ProtoMessage()    {}
+func (*QuerySuperResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{3}
+}
+func (m *QuerySuperResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuperResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuperResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuperResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuperResponse.Merge(m, src)
+}
+func (m *QuerySuperResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuperResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuperResponse.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QuerySuperResponse proto.InternalMessageInfo
+
+func (m *QuerySuperResponse) GetSuper() Super {
+	if m != nil {
+		return m.Super
+	}
+	return Super{}
 }
 
-func (c *queryClient) Supers(ctx context.Context, in *QuerySupersRequest, opts ...grpc.CallOption) (*QuerySupersResponse, error) {
-	out := new(QuerySupersResponse)
-	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Supers", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryPendingActionsRequest is request type for the Query/PendingActions RPC method
+type QueryPendingActionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingActionsRequest) Reset()         { *m = QueryPendingActionsRequest{} }
+func (m *QueryPendingActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingActionsRequest) ProtoMessage()    {}
+func (*QueryPendingActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{4}
+}
+func (m *QueryPendingActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPendingActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingActionsRequest.Merge(m, src)
+}
+func (m *QueryPendingActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingActionsRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Supers returns all Supers
-	Supers(context.Context, *QuerySupersRequest) (*QuerySupersResponse, error)
+var xxx_messageInfo_QueryPendingActionsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingActionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryPendingActionsResponse is response type for the Query/PendingActions RPC method
+type QueryPendingActionsResponse struct {
+	PendingActions []PendingAction     `protobuf:"bytes,1,rep,name=pending_actions,json=pendingActions,proto3" json:"pending_actions" yaml:"pending_actions"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Supers(ctx context.Context, req *QuerySupersRequest) (*QuerySupersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Supers not implemented")
+func (m *QueryPendingActionsResponse) Reset()         { *m = QueryPendingActionsResponse{} }
+func (m *QueryPendingActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingActionsResponse) ProtoMessage()    {}
+func (*QueryPendingActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{5}
+}
+func (m *QueryPendingActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingActionsResponse.Merge(m, src)
+}
+func (m *QueryPendingActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingActionsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryPendingActionsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingActionsResponse) GetPendingActions() []PendingAction {
+	if m != nil {
+		return m.PendingActions
+	}
+	return nil
 }
 
-func _Query_Supers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySupersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryPendingActionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Supers(ctx, in)
+	return nil
+}
+
+// QueryPendingActionRequest is request type for the Query/PendingAction RPC method
+type QueryPendingActionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryPendingActionRequest) Reset()         { *m = QueryPendingActionRequest{} }
+func (m *QueryPendingActionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingActionRequest) ProtoMessage()    {}
+func (*QueryPendingActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{6}
+}
+func (m *QueryPendingActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/irishub.guardian.Query/Supers",
+}
+func (m *QueryPendingActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingActionRequest.Merge(m, src)
+}
+func (m *QueryPendingActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingActionRequest proto.InternalMessageInfo
+
+func (m *QueryPendingActionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Supers(ctx, req.(*QuerySupersRequest))
+	return 0
+}
+
+// QueryPendingActionResponse is response type for the Query/PendingAction RPC method
+type QueryPendingActionResponse struct {
+	PendingAction PendingAction `protobuf:"bytes,1,opt,name=pending_action,json=pendingAction,proto3" json:"pending_action" yaml:"pending_action"`
+}
+
+func (m *QueryPendingActionResponse) Reset()         { *m = QueryPendingActionResponse{} }
+func (m *QueryPendingActionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingActionResponse) ProtoMessage()    {}
+func (*QueryPendingActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{7}
+}
+func (m *QueryPendingActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPendingActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingActionResponse.Merge(m, src)
+}
+func (m *QueryPendingActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingActionResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "irishub.guardian.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Supers",
-			Handler:    _Query_Supers_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "guardian/query.proto",
+var xxx_messageInfo_QueryPendingActionResponse proto.InternalMessageInfo
+
+func (m *QueryPendingActionResponse) GetPendingAction() PendingAction {
+	if m != nil {
+		return m.PendingAction
+	}
+	return PendingAction{}
 }
 
-func (m *QuerySupersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryAuditLogRequest is request type for the Query/AuditLog RPC method
+type QueryAuditLogRequest struct {
+	// the records of the other supers are skipped, if not empty
+	Target     string             `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuditLogRequest) Reset()         { *m = QueryAuditLogRequest{} }
+func (m *QueryAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogRequest) ProtoMessage()    {}
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{8}
+}
+func (m *QueryAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogRequest.Merge(m, src)
+}
+func (m *QueryAuditLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditLogRequest proto.InternalMessageInfo
+
+func (m *QueryAuditLogRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *QueryAuditLogRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuditLogResponse is response type for the Query/AuditLog RPC method
+type QueryAuditLogResponse struct {
+	Records    []AuditRecord       `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuditLogResponse) Reset()         { *m = QueryAuditLogResponse{} }
+func (m *QueryAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogResponse) ProtoMessage()    {}
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{9}
+}
+func (m *QueryAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogResponse.Merge(m, src)
+}
+func (m *QueryAuditLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditLogResponse proto.InternalMessageInfo
+
+func (m *QueryAuditLogResponse) GetRecords() []AuditRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryAuditLogResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDisabledMsgTypesRequest is request type for the Query/DisabledMsgTypes RPC method
+type QueryDisabledMsgTypesRequest struct {
+}
+
+func (m *QueryDisabledMsgTypesRequest) Reset()         { *m = QueryDisabledMsgTypesRequest{} }
+func (m *QueryDisabledMsgTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisabledMsgTypesRequest) ProtoMessage()    {}
+func (*QueryDisabledMsgTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{10}
+}
+func (m *QueryDisabledMsgTypesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisabledMsgTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisabledMsgTypesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDisabledMsgTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisabledMsgTypesRequest.Merge(m, src)
+}
+func (m *QueryDisabledMsgTypesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisabledMsgTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisabledMsgTypesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDisabledMsgTypesRequest proto.InternalMessageInfo
+
+// QueryDisabledMsgTypesResponse is response type for the Query/DisabledMsgTypes RPC method
+type QueryDisabledMsgTypesResponse struct {
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+}
+
+func (m *QueryDisabledMsgTypesResponse) Reset()         { *m = QueryDisabledMsgTypesResponse{} }
+func (m *QueryDisabledMsgTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDisabledMsgTypesResponse) ProtoMessage()    {}
+func (*QueryDisabledMsgTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{11}
+}
+func (m *QueryDisabledMsgTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisabledMsgTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisabledMsgTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDisabledMsgTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisabledMsgTypesResponse.Merge(m, src)
+}
+func (m *QueryDisabledMsgTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisabledMsgTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisabledMsgTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDisabledMsgTypesResponse proto.InternalMessageInfo
+
+func (m *QueryDisabledMsgTypesResponse) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+// QueryFrozenAccountsRequest is request type for the Query/FrozenAccounts RPC method
+type QueryFrozenAccountsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFrozenAccountsRequest) Reset()         { *m = QueryFrozenAccountsRequest{} }
+func (m *QueryFrozenAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAccountsRequest) ProtoMessage()    {}
+func (*QueryFrozenAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{12}
+}
+func (m *QueryFrozenAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAccountsRequest.Merge(m, src)
+}
+func (m *QueryFrozenAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFrozenAccountsResponse is response type for the Query/FrozenAccounts RPC method
+type QueryFrozenAccountsResponse struct {
+	FrozenAccounts []FrozenAccount     `protobuf:"bytes,1,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts" yaml:"frozen_accounts"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFrozenAccountsResponse) Reset()         { *m = QueryFrozenAccountsResponse{} }
+func (m *QueryFrozenAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAccountsResponse) ProtoMessage()    {}
+func (*QueryFrozenAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{13}
+}
+func (m *QueryFrozenAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAccountsResponse.Merge(m, src)
+}
+func (m *QueryFrozenAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenAccountsResponse) GetFrozenAccounts() []FrozenAccount {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
+func (m *QueryFrozenAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFrozenAccountRequest is request type for the Query/FrozenAccount RPC method
+type QueryFrozenAccountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFrozenAccountRequest) Reset()         { *m = QueryFrozenAccountRequest{} }
+func (m *QueryFrozenAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAccountRequest) ProtoMessage()    {}
+func (*QueryFrozenAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{14}
+}
+func (m *QueryFrozenAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAccountRequest.Merge(m, src)
+}
+func (m *QueryFrozenAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenAccountRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryFrozenAccountResponse is response type for the Query/FrozenAccount RPC method
+type QueryFrozenAccountResponse struct {
+	FrozenAccount FrozenAccount `protobuf:"bytes,1,opt,name=frozen_account,json=frozenAccount,proto3" json:"frozen_account" yaml:"frozen_account"`
+}
+
+func (m *QueryFrozenAccountResponse) Reset()         { *m = QueryFrozenAccountResponse{} }
+func (m *QueryFrozenAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAccountResponse) ProtoMessage()    {}
+func (*QueryFrozenAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{15}
+}
+func (m *QueryFrozenAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAccountResponse.Merge(m, src)
+}
+func (m *QueryFrozenAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenAccountResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenAccountResponse) GetFrozenAccount() FrozenAccount {
+	if m != nil {
+		return m.FrozenAccount
+	}
+	return FrozenAccount{}
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{16}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{17}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QuerySupersRequest)(nil), "irishub.guardian.QuerySupersRequest")
+	proto.RegisterType((*QuerySupersResponse)(nil), "irishub.guardian.QuerySupersResponse")
+	proto.RegisterType((*QuerySuperRequest)(nil), "irishub.guardian.QuerySuperRequest")
+	proto.RegisterType((*QuerySuperResponse)(nil), "irishub.guardian.QuerySuperResponse")
+	proto.RegisterType((*QueryPendingActionsRequest)(nil), "irishub.guardian.QueryPendingActionsRequest")
+	proto.RegisterType((*QueryPendingActionsResponse)(nil), "irishub.guardian.QueryPendingActionsResponse")
+	proto.RegisterType((*QueryPendingActionRequest)(nil), "irishub.guardian.QueryPendingActionRequest")
+	proto.RegisterType((*QueryPendingActionResponse)(nil), "irishub.guardian.QueryPendingActionResponse")
+	proto.RegisterType((*QueryAuditLogRequest)(nil), "irishub.guardian.QueryAuditLogRequest")
+	proto.RegisterType((*QueryAuditLogResponse)(nil), "irishub.guardian.QueryAuditLogResponse")
+	proto.RegisterType((*QueryDisabledMsgTypesRequest)(nil), "irishub.guardian.QueryDisabledMsgTypesRequest")
+	proto.RegisterType((*QueryDisabledMsgTypesResponse)(nil), "irishub.guardian.QueryDisabledMsgTypesResponse")
+	proto.RegisterType((*QueryFrozenAccountsRequest)(nil), "irishub.guardian.QueryFrozenAccountsRequest")
+	proto.RegisterType((*QueryFrozenAccountsResponse)(nil), "irishub.guardian.QueryFrozenAccountsResponse")
+	proto.RegisterType((*QueryFrozenAccountRequest)(nil), "irishub.guardian.QueryFrozenAccountRequest")
+	proto.RegisterType((*QueryFrozenAccountResponse)(nil), "irishub.guardian.QueryFrozenAccountResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "irishub.guardian.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "irishub.guardian.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("guardian/query.proto", fileDescriptor_20cf24f8e5be2110) }
+
+var fileDescriptor_20cf24f8e5be2110 = []byte{
+	// 1038 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xc0, 0x33, 0x6e, 0xe2, 0x36, 0x2f, 0xd8, 0x2d, 0x13, 0x37, 0x71, 0xb7, 0xf5, 0x07, 0x93,
+	0x34, 0x98, 0x26, 0xf1, 0x8a, 0x44, 0x45, 0x6a, 0x04, 0x87, 0x58, 0x08, 0x09, 0x41, 0xa5, 0xb0,
+	0x80, 0x90, 0x90, 0x90, 0xb5, 0xf1, 0x4e, 0xb7, 0x2b, 0xd9, 0x3b, 0xdb, 0xdd, 0xb5, 0x2a, 0x53,
+	0x15, 0x21, 0xb8, 0x70, 0x44, 0x20, 0x24, 0xc4, 0x05, 0xf1, 0xb7, 0x70, 0xe9, 0xb1, 0x12, 0x17,
+	0x4e, 0x16, 0x4a, 0x90, 0xb8, 0xe7, 0x2f, 0x40, 0x9e, 0x99, 0xb5, 0x33, 0xfb, 0x91, 0x35, 0x0a,
+	0xb7, 0xdd, 0x7d, 0x5f, 0xbf, 0xf7, 0xe6, 0xbd, 0x37, 0x36, 0x54, 0xec, 0xa1, 0xe9, 0x5b, 0x8e,
+	0xe9, 0xea, 0x4f, 0x86, 0xd4, 0x1f, 0xb5, 0x3d, 0x9f, 0x85, 0x0c, 0xdf, 0x70, 0x7c, 0x27, 0x78,
+	0x3c, 0x3c, 0x6e, 0x47, 0x52, 0xad, 0xd6, 0x63, 0xc1, 0x80, 0x05, 0x42, 0x4b, 0xf7, 0x4c, 0xdb,
+	0x71, 0xcd, 0xd0, 0x61, 0xae, 0x30, 0xd0, 0x2a, 0x36, 0xb3, 0x19, 0x7f, 0xd4, 0x27, 0x4f, 0xf2,
+	0xeb, 0xfa, 0xd4, 0x79, 0xf4, 0x20, 0x05, 0x77, 0x6c, 0xc6, 0xec, 0x3e, 0xd5, 0x4d, 0xcf, 0xd1,
+	0x4d, 0xd7, 0x65, 0x21, 0xf7, 0x15, 0x08, 0x29, 0xf9, 0x07, 0x01, 0xfe, 0x68, 0x12, 0xe7, 0xe3,
+	0xa1, 0x47, 0xfd, 0xc0, 0xa0, 0x4f, 0x86, 0x34, 0x08, 0xf1, 0x3d, 0x58, 0xf4, 0x59, 0x9f, 0x56,
+	0x51, 0x13, 0xb5, 0xca, 0x7b, 0x6b, 0xed, 0x38, 0x63, 0xdb, 0x60, 0x7d, 0x6a, 0x70, 0x1d, 0x7c,
+	0x00, 0xaf, 0x98, 0xbd, 0x1e, 0x1b, 0xba, 0x61, 0x37, 0x1c, 0x79, 0xb4, 0x5a, 0x68, 0xa2, 0xd6,
+	0x72, 0x67, 0xfd, 0x6c, 0xdc, 0x58, 0x1d, 0x99, 0x83, 0xfe, 0x01, 0x39, 0x2f, 0x25, 0xc6, 0x8a,
+	0x7c, 0xfd, 0x64, 0xe4, 0x51, 0xdc, 0x86, 0x6b, 0xa6, 0x65, 0x51, 0xab, 0x7b, 0x3c, 0xaa, 0x5e,
+	0xe1, 0x76, 0xab, 0x67, 0xe3, 0xc6, 0x75, 0x69, 0x27, 0x25, 0xc4, 0xb8, 0xca, 0x1f, 0x3b, 0x23,
+	0xfc, 0x00, 0x60, 0x56, 0x8f, 0xea, 0x62, 0x13, 0xb5, 0x56, 0xf6, 0x6e, 0xb5, 0x45, 0xbd, 0xda,
+	0xa2, 0xaa, 0x47, 0xa6, 0x4d, 0x65, 0x1a, 0xc6, 0x39, 0x65, 0xf2, 0x1d, 0x82, 0x55, 0x25, 0xd3,
+	0xc0, 0x63, 0x6e, 0x40, 0xf1, 0x7d, 0x28, 0x06, 0xfc, 0x4b, 0x15, 0x35, 0xaf, 0xb4, 0x56, 0xf6,
+	0xd6, 0x93, 0xc9, 0x72, 0x8b, 0xce, 0xe2, 0x8b, 0x71, 0x63, 0xc1, 0x90, 0xca, 0xf8, 0x40, 0x21,
+	0x29, 0x70, 0x12, 0x2d, 0x8d, 0x44, 0x84, 0x51, 0x50, 0x76, 0xe1, 0xd5, 0x19, 0x49, 0x54, 0xf2,
+	0x2a, 0x4c, 0xb2, 0xf4, 0x69, 0x10, 0xf0, 0xaa, 0x2f, 0x1b, 0xd1, 0x2b, 0x79, 0xff, 0xfc, 0x11,
+	0x4d, 0xb9, 0xf7, 0x61, 0x89, 0xa3, 0x70, 0xed, 0x5c, 0x6c, 0xa1, 0x4b, 0x3e, 0x03, 0x8d, 0xbb,
+	0x3a, 0xa2, 0xae, 0xe5, 0xb8, 0xf6, 0x61, 0x8f, 0xf7, 0x42, 0x84, 0xa0, 0x56, 0x17, 0xfd, 0x97,
+	0xea, 0xfe, 0x8e, 0xe0, 0x76, 0xaa, 0x67, 0x49, 0xfb, 0x18, 0xae, 0x7b, 0x42, 0xd2, 0x35, 0x85,
+	0x48, 0x96, 0xbb, 0x91, 0xe4, 0x56, 0x5c, 0x74, 0xea, 0x13, 0xfe, 0xb3, 0x71, 0x63, 0x4d, 0x34,
+	0x45, 0xcc, 0x0b, 0x31, 0xca, 0x9e, 0x12, 0xf1, 0x52, 0x07, 0xb3, 0x0d, 0xb7, 0x92, 0x49, 0x44,
+	0xd5, 0x29, 0x43, 0xc1, 0xb1, 0x78, 0x55, 0x16, 0x8d, 0x82, 0x63, 0x91, 0x6f, 0x51, 0x5a, 0x31,
+	0xa7, 0x19, 0x53, 0x28, 0xab, 0xac, 0xb2, 0xa0, 0xb9, 0x09, 0xd7, 0x64, 0xc2, 0x37, 0xd3, 0x12,
+	0x26, 0x46, 0x49, 0xc9, 0x97, 0x38, 0x50, 0xe1, 0x10, 0x87, 0x43, 0xcb, 0x09, 0x3f, 0x64, 0x76,
+	0x44, 0xbb, 0x06, 0xc5, 0xd0, 0xf4, 0x6d, 0x1a, 0xca, 0x6e, 0x92, 0x6f, 0xf8, 0x41, 0x4a, 0x79,
+	0xe6, 0x3c, 0xe3, 0x1f, 0x10, 0xdc, 0x8c, 0xc5, 0x92, 0xb9, 0xbe, 0x03, 0x57, 0x7d, 0xda, 0x63,
+	0xbe, 0x15, 0x9d, 0x6a, 0x2d, 0x99, 0x24, 0x37, 0x32, 0xb8, 0x96, 0xec, 0xc9, 0xc8, 0xe6, 0x52,
+	0x47, 0x56, 0x87, 0x3b, 0x9c, 0xe9, 0x5d, 0x27, 0x30, 0x8f, 0xfb, 0xd4, 0x7a, 0x18, 0xd8, 0x93,
+	0xcd, 0x12, 0xf5, 0x34, 0xf9, 0x02, 0x6a, 0x19, 0x72, 0xc9, 0xfe, 0x36, 0x94, 0x06, 0x81, 0xcd,
+	0x97, 0x53, 0x77, 0xe8, 0xf7, 0x45, 0x06, 0xcb, 0x9d, 0xea, 0xd9, 0xb8, 0x51, 0x11, 0x27, 0xa0,
+	0x88, 0x89, 0xb1, 0x32, 0x10, 0x2e, 0x3e, 0x9d, 0xbc, 0x45, 0x03, 0xf5, 0x9e, 0xcf, 0xbe, 0xa4,
+	0xee, 0xa1, 0x58, 0x6d, 0xff, 0xeb, 0x40, 0xc5, 0x3d, 0xcf, 0x06, 0xea, 0x11, 0x97, 0x74, 0xe5,
+	0x3e, 0xbd, 0x60, 0xa0, 0x14, 0x17, 0xf1, 0x81, 0x8a, 0x79, 0x21, 0x46, 0xf9, 0x91, 0x12, 0xf1,
+	0x52, 0xa7, 0x73, 0x5f, 0x0e, 0x94, 0x42, 0x90, 0xbf, 0xf1, 0xa6, 0xa3, 0x15, 0xb3, 0x9b, 0x8d,
+	0x96, 0x4a, 0x9d, 0x3d, 0x5a, 0x6a, 0xea, 0xb1, 0xd1, 0x52, 0x9d, 0x10, 0xa3, 0xa4, 0x64, 0x4e,
+	0x2a, 0x72, 0xef, 0x1e, 0x99, 0xbe, 0x39, 0x98, 0x36, 0xd4, 0x43, 0x58, 0x55, 0xbe, 0x4a, 0xa6,
+	0xb7, 0xa0, 0xe8, 0xf1, 0x2f, 0x92, 0xa5, 0x9a, 0x32, 0xe6, 0x5c, 0x1e, 0xdd, 0x23, 0x42, 0x7b,
+	0xef, 0x27, 0x80, 0x25, 0xee, 0x0f, 0x3f, 0x85, 0xa2, 0xb8, 0x9a, 0xf0, 0x66, 0xd2, 0x36, 0x79,
+	0x47, 0x6b, 0x77, 0x73, 0xb4, 0x04, 0x18, 0x69, 0x7e, 0xf3, 0xc7, 0xdf, 0x3f, 0x16, 0x34, 0x5c,
+	0xd5, 0xa5, 0xfa, 0xf4, 0x07, 0x82, 0x2e, 0xaf, 0xb2, 0xaf, 0x60, 0x89, 0xdb, 0xe0, 0x8d, 0x8b,
+	0x3c, 0x46, 0x61, 0x37, 0x2f, 0x56, 0x92, 0x51, 0xef, 0xf1, 0xa8, 0x9b, 0x98, 0x64, 0x45, 0xd5,
+	0x9f, 0xc9, 0xc3, 0x7e, 0x8e, 0x7f, 0x46, 0x50, 0x56, 0xaf, 0x0d, 0xbc, 0x93, 0x11, 0x24, 0xf5,
+	0xde, 0xd2, 0x76, 0xe7, 0xd4, 0x96, 0x6c, 0x6f, 0x70, 0xb6, 0x0d, 0xfc, 0x5a, 0x92, 0x2d, 0x76,
+	0xbb, 0xe0, 0x5f, 0x10, 0x94, 0x14, 0x2f, 0x78, 0x7b, 0x9e, 0x58, 0x11, 0xd8, 0xce, 0x7c, 0xca,
+	0x92, 0xab, 0xcd, 0xb9, 0x5a, 0x78, 0x2b, 0x97, 0x4b, 0x7f, 0xe6, 0x58, 0xcf, 0xf1, 0xd7, 0x08,
+	0xae, 0x45, 0xab, 0x18, 0x6f, 0x65, 0x84, 0x8a, 0xdd, 0x0b, 0xda, 0xeb, 0xb9, 0x7a, 0x92, 0x66,
+	0x83, 0xd3, 0xd4, 0xf0, 0xed, 0x24, 0x8d, 0x39, 0xd1, 0xed, 0xf6, 0x99, 0x8d, 0x7f, 0x43, 0x70,
+	0x23, 0xbe, 0x59, 0x71, 0x3b, 0x23, 0x44, 0xc6, 0x8a, 0xd6, 0xf4, 0xb9, 0xf5, 0x25, 0xda, 0x0e,
+	0x47, 0xdb, 0xc2, 0x9b, 0x49, 0x34, 0x4b, 0xda, 0x74, 0xa3, 0xa5, 0x1d, 0xf0, 0xf6, 0x52, 0x97,
+	0x68, 0x66, 0x7b, 0xa5, 0x6e, 0x71, 0x6d, 0x77, 0x4e, 0xed, 0xfc, 0xf6, 0x8a, 0xed, 0x5a, 0xfc,
+	0x2b, 0x82, 0x92, 0xe2, 0x25, 0xb3, 0xbd, 0xd2, 0x16, 0xa8, 0xb6, 0x33, 0x9f, 0xb2, 0xe4, 0xda,
+	0xe7, 0x5c, 0xbb, 0x78, 0x3b, 0x97, 0xeb, 0xdc, 0x6c, 0x3e, 0x85, 0xa2, 0x58, 0x5b, 0x99, 0x4b,
+	0x49, 0xd9, 0x8e, 0xda, 0xdd, 0x1c, 0xad, 0xfc, 0xa5, 0x24, 0xf6, 0x62, 0xe7, 0x83, 0x17, 0x27,
+	0x75, 0xf4, 0xf2, 0xa4, 0x8e, 0xfe, 0x3a, 0xa9, 0xa3, 0xef, 0x4f, 0xeb, 0x0b, 0x2f, 0x4f, 0xeb,
+	0x0b, 0x7f, 0x9e, 0xd6, 0x17, 0x3e, 0x7f, 0xd3, 0x76, 0xc2, 0x49, 0x80, 0x1e, 0x1b, 0x70, 0x6b,
+	0x97, 0x86, 0x53, 0x2f, 0x03, 0x66, 0x0d, 0xfb, 0x34, 0x98, 0x79, 0xe3, 0x2d, 0x70, 0x5c, 0xe4,
+	0x7f, 0x76, 0xf6, 0xff, 0x1d, 0x00, 0xf0, 0x0f, 0x53, 0x58, 0x82, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Supers returns all Supers
+	Supers(ctx context.Context, in *QuerySupersRequest, opts ...grpc.CallOption) (*QuerySupersResponse, error)
+	// Super returns the Super of the address
+	Super(ctx context.Context, in *QuerySuperRequest, opts ...grpc.CallOption) (*QuerySuperResponse, error)
+	// PendingActions returns all pending actions
+	PendingActions(ctx context.Context, in *QueryPendingActionsRequest, opts ...grpc.CallOption) (*QueryPendingActionsResponse, error)
+	// PendingAction returns the pending action of the id
+	PendingAction(ctx context.Context, in *QueryPendingActionRequest, opts ...grpc.CallOption) (*QueryPendingActionResponse, error)
+	// AuditLog returns the audit log of the membership changes
+	AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	// DisabledMsgTypes returns the type URLs of the msgs disabled by the circuit breakers
+	DisabledMsgTypes(ctx context.Context, in *QueryDisabledMsgTypesRequest, opts ...grpc.CallOption) (*QueryDisabledMsgTypesResponse, error)
+	// FrozenAccounts returns all frozen accounts
+	FrozenAccounts(ctx context.Context, in *QueryFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error)
+	// FrozenAccount returns the frozen account of the address
+	FrozenAccount(ctx context.Context, in *QueryFrozenAccountRequest, opts ...grpc.CallOption) (*QueryFrozenAccountResponse, error)
+	// Params queries the guardian parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Supers(ctx context.Context, in *QuerySupersRequest, opts ...grpc.CallOption) (*QuerySupersResponse, error) {
+	out := new(QuerySupersResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Supers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Super(ctx context.Context, in *QuerySuperRequest, opts ...grpc.CallOption) (*QuerySuperResponse, error) {
+	out := new(QuerySuperResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Super", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingActions(ctx context.Context, in *QueryPendingActionsRequest, opts ...grpc.CallOption) (*QueryPendingActionsResponse, error) {
+	out := new(QueryPendingActionsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/PendingActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingAction(ctx context.Context, in *QueryPendingActionRequest, opts ...grpc.CallOption) (*QueryPendingActionResponse, error) {
+	out := new(QueryPendingActionResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/PendingAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/AuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisabledMsgTypes(ctx context.Context, in *QueryDisabledMsgTypesRequest, opts ...grpc.CallOption) (*QueryDisabledMsgTypesResponse, error) {
+	out := new(QueryDisabledMsgTypesResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/DisabledMsgTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAccounts(ctx context.Context, in *QueryFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error) {
+	out := new(QueryFrozenAccountsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/FrozenAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAccount(ctx context.Context, in *QueryFrozenAccountRequest, opts ...grpc.CallOption) (*QueryFrozenAccountResponse, error) {
+	out := new(QueryFrozenAccountResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/FrozenAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Supers returns all Supers
+	Supers(context.Context, *QuerySupersRequest) (*QuerySupersResponse, error)
+	// Super returns the Super of the address
+	Super(context.Context, *QuerySuperRequest) (*QuerySuperResponse, error)
+	// PendingActions returns all pending actions
+	PendingActions(context.Context, *QueryPendingActionsRequest) (*QueryPendingActionsResponse, error)
+	// PendingAction returns the pending action of the id
+	PendingAction(context.Context, *QueryPendingActionRequest) (*QueryPendingActionResponse, error)
+	// AuditLog returns the audit log of the membership changes
+	AuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	// DisabledMsgTypes returns the type URLs of the msgs disabled by the circuit breakers
+	DisabledMsgTypes(context.Context, *QueryDisabledMsgTypesRequest) (*QueryDisabledMsgTypesResponse, error)
+	// FrozenAccounts returns all frozen accounts
+	FrozenAccounts(context.Context, *QueryFrozenAccountsRequest) (*QueryFrozenAccountsResponse, error)
+	// FrozenAccount returns the frozen account of the address
+	FrozenAccount(context.Context, *QueryFrozenAccountRequest) (*QueryFrozenAccountResponse, error)
+	// Params queries the guardian parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Supers(ctx context.Context, req *QuerySupersRequest) (*QuerySupersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Supers not implemented")
+}
+func (*UnimplementedQueryServer) Super(ctx context.Context, req *QuerySuperRequest) (*QuerySuperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Super not implemented")
+}
+func (*UnimplementedQueryServer) PendingActions(ctx context.Context, req *QueryPendingActionsRequest) (*QueryPendingActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingActions not implemented")
+}
+func (*UnimplementedQueryServer) PendingAction(ctx context.Context, req *QueryPendingActionRequest) (*QueryPendingActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingAction not implemented")
+}
+func (*UnimplementedQueryServer) AuditLog(ctx context.Context, req *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLog not implemented")
+}
+func (*UnimplementedQueryServer) DisabledMsgTypes(ctx context.Context, req *QueryDisabledMsgTypesRequest) (*QueryDisabledMsgTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisabledMsgTypes not implemented")
+}
+func (*UnimplementedQueryServer) FrozenAccounts(ctx context.Context, req *QueryFrozenAccountsRequest) (*QueryFrozenAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAccounts not implemented")
+}
+func (*UnimplementedQueryServer) FrozenAccount(ctx context.Context, req *QueryFrozenAccountRequest) (*QueryFrozenAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAccount not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Supers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Supers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/Supers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Supers(ctx, req.(*QuerySupersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Super_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySuperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Super(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/Super",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Super(ctx, req.(*QuerySuperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/PendingActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingActions(ctx, req.(*QueryPendingActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/PendingAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAction(ctx, req.(*QueryPendingActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/AuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisabledMsgTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisabledMsgTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisabledMsgTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/DisabledMsgTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisabledMsgTypes(ctx, req.(*QueryDisabledMsgTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/FrozenAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAccounts(ctx, req.(*QueryFrozenAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/FrozenAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAccount(ctx, req.(*QueryFrozenAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irishub.guardian.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Supers",
+			Handler:    _Query_Supers_Handler,
+		},
+		{
+			MethodName: "Super",
+			Handler:    _Query_Super_Handler,
+		},
+		{
+			MethodName: "PendingActions",
+			Handler:    _Query_PendingActions_Handler,
+		},
+		{
+			MethodName: "PendingAction",
+			Handler:    _Query_PendingAction_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _Query_AuditLog_Handler,
+		},
+		{
+			MethodName: "DisabledMsgTypes",
+			Handler:    _Query_DisabledMsgTypes_Handler,
+		},
+		{
+			MethodName: "FrozenAccounts",
+			Handler:    _Query_FrozenAccounts_Handler,
+		},
+		{
+			MethodName: "FrozenAccount",
+			Handler:    _Query_FrozenAccount_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "guardian/query.proto",
+}
+
+func (m *QuerySupersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountType) > 0 {
+		i -= len(m.AccountType)
+		copy(dAtA[i:], m.AccountType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Supers) > 0 {
+		for iNdEx := len(m.Supers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuperRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuperRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuperRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Super.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingActions) > 0 {
+		for iNdEx := len(m.PendingActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingAction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisabledMsgTypesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisabledMsgTypesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisabledMsgTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisabledMsgTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisabledMsgTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisabledMsgTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FrozenAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySupersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	l = len(m.AccountType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supers) > 0 {
+		for _, e := range m.Supers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySuperRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySuperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Super.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingActions) > 0 {
+		for _, e := range m.PendingActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPendingActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingAction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuditLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuditLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDisabledMsgTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDisabledMsgTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFrozenAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FrozenAccounts) > 0 {
+		for _, e := range m.FrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FrozenAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supers = append(m.Supers, Super{})
+			if err := m.Supers[len(m.Supers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySuperRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuperRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuperRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySuperResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuperResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Super", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Super.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingActions = append(m.PendingActions, PendingAction{})
+			if err := m.PendingActions[len(m.PendingActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingAction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuditLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuditLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuditLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuditLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuditLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuditLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, AuditRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisabledMsgTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisabledMsgTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisabledMsgTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisabledMsgTypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisabledMsgTypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisabledMsgTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, FrozenAccount{})
+			if err := m.FrozenAccounts[len(m.FrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySupersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySupersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func (m *QuerySupersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySupersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
-
-func (m *QuerySupersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Supers) > 0 {
-		for iNdEx := len(m.Supers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Supers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+func (m *QueryFrozenAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QuerySupersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QuerySupersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Supers) > 0 {
-		for _, e := range m.Supers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFrozenAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -346,10 +3929,10 @@ func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -376,7 +3959,7 @@ func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -399,15 +3982,15 @@ func (m *QuerySupersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -434,8 +4017,7 @@ func (m *QuerySupersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supers = append(m.Supers, Super{})
-			if err := m.Supers[len(m.Supers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6,20 +6,25 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +37,11 @@ type MsgAddSuper struct {
 	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
 	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	AddedBy     string `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	Roles       []Role `protobuf:"varint,4,rep,packed,name=roles,proto3,enum=irishub.guardian.Role" json:"roles,omitempty"`
+	// the super expires at the time, if set
+	ExpiryTime *time.Time `protobuf:"bytes,5,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" yaml:"expiry_time"`
+	// the super expires at the height, if not zero
+	ExpiryHeight int64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
 }
 
 func (m *MsgAddSuper) Reset()         { *m = MsgAddSuper{} }
@@ -88,8 +98,31 @@ func (m *MsgAddSuper) GetAddedBy() string {
 	return ""
 }
 
+func (m *MsgAddSuper) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *MsgAddSuper) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+func (m *MsgAddSuper) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
 // MsgAddSuperResponse defines the Msg/AddSuper response type
 type MsgAddSuperResponse struct {
+	// the id of the pending action created, zero if the super is added at once
+	ActionId uint64 `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
 }
 
 func (m *MsgAddSuperResponse) Reset()         { *m = MsgAddSuperResponse{} }
@@ -125,6 +158,13 @@ func (m *MsgAddSuperResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddSuperResponse proto.InternalMessageInfo
 
+func (m *MsgAddSuperResponse) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
+}
+
 // DeleteSuper defines the properties of delete super account message
 type MsgDeleteSuper struct {
 	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -180,6 +220,8 @@ func (m *MsgDeleteSuper) GetDeletedBy() string {
 
 // MsgDeleteSuperResponse defines the Msg/DeleteSuper response type
 type MsgDeleteSuperResponse struct {
+	// the id of the pending action created, zero if the super is deleted at once
+	ActionId uint64 `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
 }
 
 func (m *MsgDeleteSuperResponse) Reset()         { *m = MsgDeleteSuperResponse{} }
@@ -215,359 +257,3654 @@ func (m *MsgDeleteSuperResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteSuperResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddSuper)(nil), "irishub.guardian.MsgAddSuper")
-	proto.RegisterType((*MsgAddSuperResponse)(nil), "irishub.guardian.MsgAddSuperResponse")
-	proto.RegisterType((*MsgDeleteSuper)(nil), "irishub.guardian.MsgDeleteSuper")
-	proto.RegisterType((*MsgDeleteSuperResponse)(nil), "irishub.guardian.MsgDeleteSuperResponse")
+func (m *MsgDeleteSuperResponse) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("guardian/tx.proto", fileDescriptor_b62288115d705ce8) }
+// MsgSetRoles defines the properties of set roles message
+type MsgSetRoles struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Roles   []Role `protobuf:"varint,2,rep,packed,name=roles,proto3,enum=irishub.guardian.Role" json:"roles,omitempty"`
+	SetBy   string `protobuf:"bytes,3,opt,name=set_by,json=setBy,proto3" json:"set_by,omitempty"`
+}
 
-var fileDescriptor_b62288115d705ce8 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xc8,
-	0x2c, 0xca, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x83, 0x49, 0x29, 0xa5, 0x70, 0x71, 0xfb, 0x16, 0xa7,
-	0x3b, 0xa6, 0xa4, 0x04, 0x97, 0x16, 0xa4, 0x16, 0x09, 0x29, 0x70, 0x71, 0xa7, 0xa4, 0x16, 0x27,
-	0x17, 0x65, 0x16, 0x94, 0x64, 0xe6, 0xe7, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x21, 0x0b,
-	0x09, 0x49, 0x70, 0xb1, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x81, 0x65, 0x61,
-	0x5c, 0x21, 0x49, 0x2e, 0x8e, 0xc4, 0x94, 0x94, 0xd4, 0x94, 0xf8, 0xa4, 0x4a, 0x09, 0x66, 0xb8,
-	0x54, 0x6a, 0x8a, 0x53, 0xa5, 0x92, 0x28, 0x97, 0x30, 0x92, 0x2d, 0x41, 0xa9, 0xc5, 0x05, 0xf9,
-	0x79, 0xc5, 0xa9, 0x4a, 0x9e, 0x5c, 0x7c, 0xbe, 0xc5, 0xe9, 0x2e, 0xa9, 0x39, 0xa9, 0x25, 0xa9,
-	0x10, 0xfb, 0x71, 0x9b, 0x2e, 0xcb, 0xc5, 0x95, 0x02, 0x56, 0x88, 0x64, 0x3e, 0x27, 0x54, 0xc4,
-	0xa9, 0x52, 0x49, 0x82, 0x4b, 0x0c, 0xd5, 0x28, 0x98, 0x25, 0x46, 0x9b, 0x18, 0xb9, 0x98, 0x7d,
-	0x8b, 0xd3, 0x85, 0x02, 0xb8, 0x38, 0xe0, 0xde, 0x94, 0xd5, 0x43, 0x0f, 0x08, 0x3d, 0x24, 0xf7,
-	0x49, 0xa9, 0xe2, 0x95, 0x86, 0x99, 0x2c, 0x14, 0xc9, 0xc5, 0x8d, 0xec, 0x76, 0x05, 0xac, 0xba,
-	0x90, 0x54, 0x48, 0x69, 0x10, 0x52, 0x01, 0x33, 0xda, 0xc9, 0xfb, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
-	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86,
-	0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x0c, 0xd3, 0x33, 0x4b, 0x40, 0x26, 0x24, 0xe7, 0xe7, 0xea, 0x83,
-	0x4c, 0xcb, 0x4b, 0x2d, 0xd1, 0x87, 0x9a, 0xaa, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0xac,
-	0x8f, 0x88, 0xf8, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xe4, 0x1b, 0x03, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x31, 0x10, 0x08, 0xaa, 0x11, 0x02, 0x00, 0x00,
+func (m *MsgSetRoles) Reset()         { *m = MsgSetRoles{} }
+func (m *MsgSetRoles) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRoles) ProtoMessage()    {}
+func (*MsgSetRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{4}
+}
+func (m *MsgSetRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRoles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRoles.Merge(m, src)
+}
+func (m *MsgSetRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRoles.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgSetRoles proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSetRoles) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// AddSuper defines a method for adding a super account
-	AddSuper(ctx context.Context, in *MsgAddSuper, opts ...grpc.CallOption) (*MsgAddSuperResponse, error)
-	// DeleteSuper defines a method for deleting a super account
-	DeleteSuper(ctx context.Context, in *MsgDeleteSuper, opts ...grpc.CallOption) (*MsgDeleteSuperResponse, error)
+func (m *MsgSetRoles) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetRoles) GetSetBy() string {
+	if m != nil {
+		return m.SetBy
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgSetRolesResponse defines the Msg/SetRoles response type
+type MsgSetRolesResponse struct {
+	// the id of the pending action created, zero if the roles are set at once
+	ActionId uint64 `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
 }
 
-func (c *msgClient) AddSuper(ctx context.Context, in *MsgAddSuper, opts ...grpc.CallOption) (*MsgAddSuperResponse, error) {
-	out := new(MsgAddSuperResponse)
-	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/AddSuper", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRolesResponse) Reset()         { *m = MsgSetRolesResponse{} }
+func (m *MsgSetRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRolesResponse) ProtoMessage()    {}
+func (*MsgSetRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{5}
+}
+func (m *MsgSetRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRolesResponse.Merge(m, src)
+}
+func (m *MsgSetRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRolesResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteSuper(ctx context.Context, in *MsgDeleteSuper, opts ...grpc.CallOption) (*MsgDeleteSuperResponse, error) {
-	out := new(MsgDeleteSuperResponse)
-	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/DeleteSuper", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetRolesResponse proto.InternalMessageInfo
+
+func (m *MsgSetRolesResponse) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// AddSuper defines a method for adding a super account
-	AddSuper(context.Context, *MsgAddSuper) (*MsgAddSuperResponse, error)
-	// DeleteSuper defines a method for deleting a super account
-	DeleteSuper(context.Context, *MsgDeleteSuper) (*MsgDeleteSuperResponse, error)
+// MsgApproveAction defines the properties of approve action message
+type MsgApproveAction struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approver string `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgApproveAction) Reset()         { *m = MsgApproveAction{} }
+func (m *MsgApproveAction) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveAction) ProtoMessage()    {}
+func (*MsgApproveAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{6}
 }
-
-func (*UnimplementedMsgServer) AddSuper(ctx context.Context, req *MsgAddSuper) (*MsgAddSuperResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSuper not implemented")
+func (m *MsgApproveAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteSuper(ctx context.Context, req *MsgDeleteSuper) (*MsgDeleteSuperResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSuper not implemented")
+func (m *MsgApproveAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgApproveAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveAction.Merge(m, src)
+}
+func (m *MsgApproveAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveAction.DiscardUnknown(m)
 }
 
-func _Msg_AddSuper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddSuper)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddSuper(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/irishub.guardian.Msg/AddSuper",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddSuper(ctx, req.(*MsgAddSuper))
+var xxx_messageInfo_MsgApproveAction proto.InternalMessageInfo
+
+func (m *MsgApproveAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_DeleteSuper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteSuper)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteSuper(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/irishub.guardian.Msg/DeleteSuper",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteSuper(ctx, req.(*MsgDeleteSuper))
+func (m *MsgApproveAction) GetApprover() string {
+	if m != nil {
+		return m.Approver
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "irishub.guardian.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddSuper",
-			Handler:    _Msg_AddSuper_Handler,
-		},
-		{
-			MethodName: "DeleteSuper",
-			Handler:    _Msg_DeleteSuper_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "guardian/tx.proto",
+// MsgApproveActionResponse defines the Msg/ApproveAction response type
+type MsgApproveActionResponse struct {
+	// whether the action reached the threshold and was executed
+	Executed bool `protobuf:"varint,1,opt,name=executed,proto3" json:"executed,omitempty"`
 }
 
-func (m *MsgAddSuper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgApproveActionResponse) Reset()         { *m = MsgApproveActionResponse{} }
+func (m *MsgApproveActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveActionResponse) ProtoMessage()    {}
+func (*MsgApproveActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{7}
 }
-
-func (m *MsgAddSuper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgApproveActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgAddSuper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddedBy) > 0 {
-		i -= len(m.AddedBy)
-		copy(dAtA[i:], m.AddedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AddedBy)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgApproveActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgApproveActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveActionResponse.Merge(m, src)
+}
+func (m *MsgApproveActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveActionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddSuperResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApproveActionResponse proto.InternalMessageInfo
+
+func (m *MsgApproveActionResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgAddSuperResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateSuper defines the properties of update super message,
+// the fields set to "[do-not-modify]" are left unchanged
+type MsgUpdateSuper struct {
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Description     string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Website         string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty" yaml:"security_contact"`
 }
 
-func (m *MsgAddSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateSuper) Reset()         { *m = MsgUpdateSuper{} }
+func (m *MsgUpdateSuper) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSuper) ProtoMessage()    {}
+func (*MsgUpdateSuper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{8}
 }
-
-func (m *MsgDeleteSuper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSuper) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSuper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSuper.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateSuper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSuper.Merge(m, src)
+}
+func (m *MsgUpdateSuper) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSuper) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSuper.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteSuper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateSuper proto.InternalMessageInfo
+
+func (m *MsgUpdateSuper) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *MsgDeleteSuper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeletedBy) > 0 {
-		i -= len(m.DeletedBy)
-		copy(dAtA[i:], m.DeletedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedBy)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgUpdateSuper) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgUpdateSuper) GetWebsite() string {
+	if m != nil {
+		return m.Website
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeleteSuperResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSuper) GetSecurityContact() string {
+	if m != nil {
+		return m.SecurityContact
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteSuperResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateSuperResponse defines the Msg/UpdateSuper response type
+type MsgUpdateSuperResponse struct {
 }
 
-func (m *MsgDeleteSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateSuperResponse) Reset()         { *m = MsgUpdateSuperResponse{} }
+func (m *MsgUpdateSuperResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSuperResponse) ProtoMessage()    {}
+func (*MsgUpdateSuperResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{9}
+}
+func (m *MsgUpdateSuperResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSuperResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSuperResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSuperResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSuperResponse.Merge(m, src)
+}
+func (m *MsgUpdateSuperResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSuperResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSuperResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSuperResponse proto.InternalMessageInfo
+
+// MsgDisableMsgTypes defines the message to disable the msg types identified by type URLs
+type MsgDisableMsgTypes struct {
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+	Operator    string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgDisableMsgTypes) Reset()         { *m = MsgDisableMsgTypes{} }
+func (m *MsgDisableMsgTypes) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableMsgTypes) ProtoMessage()    {}
+func (*MsgDisableMsgTypes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{10}
+}
+func (m *MsgDisableMsgTypes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableMsgTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableMsgTypes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisableMsgTypes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableMsgTypes.Merge(m, src)
+}
+func (m *MsgDisableMsgTypes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableMsgTypes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableMsgTypes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisableMsgTypes proto.InternalMessageInfo
+
+func (m *MsgDisableMsgTypes) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *MsgDisableMsgTypes) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// MsgDisableMsgTypesResponse defines the Msg/DisableMsgTypes response type
+type MsgDisableMsgTypesResponse struct {
+}
+
+func (m *MsgDisableMsgTypesResponse) Reset()         { *m = MsgDisableMsgTypesResponse{} }
+func (m *MsgDisableMsgTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableMsgTypesResponse) ProtoMessage()    {}
+func (*MsgDisableMsgTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{11}
+}
+func (m *MsgDisableMsgTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableMsgTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableMsgTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisableMsgTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableMsgTypesResponse.Merge(m, src)
+}
+func (m *MsgDisableMsgTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableMsgTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableMsgTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisableMsgTypesResponse proto.InternalMessageInfo
+
+// MsgEnableMsgTypes defines the message to re-enable the disabled msg types identified by type URLs
+type MsgEnableMsgTypes struct {
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+	Operator    string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgEnableMsgTypes) Reset()         { *m = MsgEnableMsgTypes{} }
+func (m *MsgEnableMsgTypes) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableMsgTypes) ProtoMessage()    {}
+func (*MsgEnableMsgTypes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{12}
+}
+func (m *MsgEnableMsgTypes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableMsgTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableMsgTypes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableMsgTypes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableMsgTypes.Merge(m, src)
+}
+func (m *MsgEnableMsgTypes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableMsgTypes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableMsgTypes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableMsgTypes proto.InternalMessageInfo
+
+func (m *MsgEnableMsgTypes) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *MsgEnableMsgTypes) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// MsgEnableMsgTypesResponse defines the Msg/EnableMsgTypes response type
+type MsgEnableMsgTypesResponse struct {
+}
+
+func (m *MsgEnableMsgTypesResponse) Reset()         { *m = MsgEnableMsgTypesResponse{} }
+func (m *MsgEnableMsgTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableMsgTypesResponse) ProtoMessage()    {}
+func (*MsgEnableMsgTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{13}
+}
+func (m *MsgEnableMsgTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableMsgTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableMsgTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableMsgTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableMsgTypesResponse.Merge(m, src)
+}
+func (m *MsgEnableMsgTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableMsgTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableMsgTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableMsgTypesResponse proto.InternalMessageInfo
+
+// MsgFreezeAccount defines the message to freeze an account
+type MsgFreezeAccount struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgFreezeAccount) Reset()         { *m = MsgFreezeAccount{} }
+func (m *MsgFreezeAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeAccount) ProtoMessage()    {}
+func (*MsgFreezeAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{14}
 }
+func (m *MsgFreezeAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeAccount.Merge(m, src)
+}
+func (m *MsgFreezeAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeAccount proto.InternalMessageInfo
+
+func (m *MsgFreezeAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgFreezeAccount) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MsgFreezeAccount) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// MsgFreezeAccountResponse defines the Msg/FreezeAccount response type
+type MsgFreezeAccountResponse struct {
+}
+
+func (m *MsgFreezeAccountResponse) Reset()         { *m = MsgFreezeAccountResponse{} }
+func (m *MsgFreezeAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeAccountResponse) ProtoMessage()    {}
+func (*MsgFreezeAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{15}
+}
+func (m *MsgFreezeAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeAccountResponse.Merge(m, src)
+}
+func (m *MsgFreezeAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeAccountResponse proto.InternalMessageInfo
+
+// MsgUnfreezeAccount defines the message to unfreeze a frozen account
+type MsgUnfreezeAccount struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgUnfreezeAccount) Reset()         { *m = MsgUnfreezeAccount{} }
+func (m *MsgUnfreezeAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeAccount) ProtoMessage()    {}
+func (*MsgUnfreezeAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{16}
+}
+func (m *MsgUnfreezeAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeAccount.Merge(m, src)
+}
+func (m *MsgUnfreezeAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeAccount proto.InternalMessageInfo
+
+func (m *MsgUnfreezeAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgUnfreezeAccount) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// MsgUnfreezeAccountResponse defines the Msg/UnfreezeAccount response type
+type MsgUnfreezeAccountResponse struct {
+}
+
+func (m *MsgUnfreezeAccountResponse) Reset()         { *m = MsgUnfreezeAccountResponse{} }
+func (m *MsgUnfreezeAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeAccountResponse) ProtoMessage()    {}
+func (*MsgUnfreezeAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{17}
+}
+func (m *MsgUnfreezeAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeAccountResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeAccountResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddSuper)(nil), "irishub.guardian.MsgAddSuper")
+	proto.RegisterType((*MsgAddSuperResponse)(nil), "irishub.guardian.MsgAddSuperResponse")
+	proto.RegisterType((*MsgDeleteSuper)(nil), "irishub.guardian.MsgDeleteSuper")
+	proto.RegisterType((*MsgDeleteSuperResponse)(nil), "irishub.guardian.MsgDeleteSuperResponse")
+	proto.RegisterType((*MsgSetRoles)(nil), "irishub.guardian.MsgSetRoles")
+	proto.RegisterType((*MsgSetRolesResponse)(nil), "irishub.guardian.MsgSetRolesResponse")
+	proto.RegisterType((*MsgApproveAction)(nil), "irishub.guardian.MsgApproveAction")
+	proto.RegisterType((*MsgApproveActionResponse)(nil), "irishub.guardian.MsgApproveActionResponse")
+	proto.RegisterType((*MsgUpdateSuper)(nil), "irishub.guardian.MsgUpdateSuper")
+	proto.RegisterType((*MsgUpdateSuperResponse)(nil), "irishub.guardian.MsgUpdateSuperResponse")
+	proto.RegisterType((*MsgDisableMsgTypes)(nil), "irishub.guardian.MsgDisableMsgTypes")
+	proto.RegisterType((*MsgDisableMsgTypesResponse)(nil), "irishub.guardian.MsgDisableMsgTypesResponse")
+	proto.RegisterType((*MsgEnableMsgTypes)(nil), "irishub.guardian.MsgEnableMsgTypes")
+	proto.RegisterType((*MsgEnableMsgTypesResponse)(nil), "irishub.guardian.MsgEnableMsgTypesResponse")
+	proto.RegisterType((*MsgFreezeAccount)(nil), "irishub.guardian.MsgFreezeAccount")
+	proto.RegisterType((*MsgFreezeAccountResponse)(nil), "irishub.guardian.MsgFreezeAccountResponse")
+	proto.RegisterType((*MsgUnfreezeAccount)(nil), "irishub.guardian.MsgUnfreezeAccount")
+	proto.RegisterType((*MsgUnfreezeAccountResponse)(nil), "irishub.guardian.MsgUnfreezeAccountResponse")
+}
+
+func init() { proto.RegisterFile("guardian/tx.proto", fileDescriptor_b62288115d705ce8) }
+
+var fileDescriptor_b62288115d705ce8 = []byte{
+	// 862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5f, 0x6f, 0xe3, 0x44,
+	0x10, 0xaf, 0x93, 0x36, 0xa4, 0x13, 0xda, 0xeb, 0x99, 0xa3, 0xe7, 0xdb, 0x72, 0x49, 0x64, 0x38,
+	0x29, 0x3a, 0xaa, 0x44, 0x14, 0xc1, 0x03, 0x02, 0xa4, 0x06, 0x38, 0x71, 0xa0, 0x48, 0x27, 0xdf,
+	0x55, 0x08, 0x5e, 0x82, 0xe3, 0x9d, 0x73, 0x2d, 0x39, 0x5e, 0x6b, 0x77, 0x0d, 0x35, 0x9f, 0xe2,
+	0x3e, 0x07, 0x0f, 0x7c, 0x0e, 0x1e, 0x4f, 0xe2, 0x85, 0xa7, 0x82, 0xda, 0x6f, 0xd0, 0x4f, 0x80,
+	0xfc, 0x6f, 0x6b, 0xbb, 0xa6, 0xc9, 0xd3, 0xbd, 0x79, 0x66, 0x7e, 0x3b, 0xbf, 0x99, 0xdf, 0xec,
+	0xac, 0x0c, 0x77, 0xdd, 0xc8, 0xe6, 0xd4, 0xb3, 0x83, 0x89, 0x3c, 0x1b, 0x87, 0x9c, 0x49, 0xa6,
+	0xef, 0x79, 0xdc, 0x13, 0xa7, 0xd1, 0x62, 0x5c, 0x84, 0xc8, 0x3d, 0x97, 0xb9, 0x2c, 0x0d, 0x4e,
+	0x92, 0xaf, 0x0c, 0x47, 0x06, 0x2e, 0x63, 0xae, 0x8f, 0x93, 0xd4, 0x5a, 0x44, 0x2f, 0x27, 0xd2,
+	0x5b, 0xa2, 0x90, 0xf6, 0x32, 0xcc, 0x01, 0xf7, 0x55, 0xee, 0xe2, 0x23, 0x0b, 0x98, 0xbf, 0xb7,
+	0xa0, 0x37, 0x13, 0xee, 0x31, 0xa5, 0xcf, 0xa3, 0x10, 0xb9, 0x3e, 0x84, 0x1e, 0x45, 0xe1, 0x70,
+	0x2f, 0x94, 0x1e, 0x0b, 0x0c, 0x6d, 0xa8, 0x8d, 0xb6, 0xad, 0xb2, 0x4b, 0x37, 0xe0, 0x2d, 0x9b,
+	0x52, 0x8e, 0x42, 0x18, 0xad, 0x34, 0x5a, 0x98, 0xfa, 0x03, 0xe8, 0xda, 0x94, 0x22, 0x9d, 0x2f,
+	0x62, 0xa3, 0xad, 0x42, 0x48, 0xa7, 0xb1, 0x7e, 0x08, 0x5b, 0x9c, 0xf9, 0x28, 0x8c, 0xcd, 0x61,
+	0x7b, 0xb4, 0x7b, 0xb4, 0x3f, 0xae, 0x37, 0x36, 0xb6, 0x98, 0x8f, 0x56, 0x06, 0xd2, 0x7f, 0x80,
+	0x1e, 0x9e, 0x85, 0x1e, 0x8f, 0xe7, 0x49, 0x1f, 0xc6, 0xd6, 0x50, 0x1b, 0xf5, 0x8e, 0xc8, 0x38,
+	0x6b, 0x72, 0x5c, 0x34, 0x39, 0x7e, 0x51, 0x34, 0x39, 0x25, 0x57, 0xe7, 0x03, 0x3d, 0xb6, 0x97,
+	0xfe, 0x67, 0x66, 0xe9, 0xa0, 0xf9, 0xea, 0x9f, 0x81, 0x66, 0x41, 0xe6, 0x49, 0xc0, 0xfa, 0x17,
+	0xb0, 0x93, 0xc7, 0x4f, 0xd1, 0x73, 0x4f, 0xa5, 0xd1, 0x19, 0x6a, 0xa3, 0xf6, 0xd4, 0xb8, 0x3a,
+	0x1f, 0xdc, 0xab, 0x1c, 0xcf, 0xc2, 0xa6, 0xf5, 0x76, 0x66, 0x7f, 0x9b, 0x99, 0x47, 0xf0, 0x4e,
+	0x49, 0x2b, 0x0b, 0x45, 0xc8, 0x02, 0x81, 0xfa, 0x01, 0x6c, 0xdb, 0x4e, 0xa2, 0xcd, 0xdc, 0xa3,
+	0xa9, 0x62, 0x9b, 0x56, 0x37, 0x73, 0x3c, 0xa5, 0xe6, 0x53, 0xd8, 0x9d, 0x09, 0xf7, 0x6b, 0xf4,
+	0x51, 0x62, 0x26, 0xf1, 0xff, 0x0b, 0xf8, 0x10, 0x80, 0xa6, 0xc0, 0x92, 0x84, 0xdb, 0xb9, 0x67,
+	0x1a, 0x9b, 0x9f, 0xc0, 0x7e, 0x35, 0xd5, 0x7a, 0x15, 0xf8, 0xe9, 0x84, 0x9f, 0xa3, 0xb4, 0x52,
+	0x71, 0x4b, 0xf4, 0x5a, 0x95, 0x5e, 0x0d, 0xa9, 0xb5, 0xce, 0x90, 0xde, 0x85, 0x8e, 0x40, 0x79,
+	0x5d, 0xe8, 0x96, 0x40, 0x39, 0x8d, 0x73, 0x8d, 0x0a, 0xb6, 0xf5, 0x2a, 0xfc, 0x12, 0xf6, 0x12,
+	0x5d, 0xc3, 0x90, 0xb3, 0x5f, 0xf0, 0x38, 0xf5, 0xea, 0xbb, 0xd0, 0x52, 0xc8, 0x96, 0x47, 0x75,
+	0x02, 0x5d, 0x3b, 0x03, 0xf0, 0x5c, 0x36, 0x65, 0x9b, 0x9f, 0x82, 0x51, 0x3f, 0xaf, 0x88, 0x09,
+	0x74, 0xf1, 0x0c, 0x9d, 0x48, 0x62, 0x96, 0xad, 0x6b, 0x29, 0xdb, 0xfc, 0x43, 0x4b, 0x87, 0x73,
+	0x12, 0x52, 0xbb, 0x61, 0x38, 0x35, 0x75, 0x6a, 0x9b, 0xd1, 0x6a, 0xdc, 0x8c, 0x5f, 0x71, 0x21,
+	0x3c, 0x89, 0xc5, 0xf5, 0xcf, 0x4d, 0xfd, 0x09, 0xec, 0x09, 0x74, 0x22, 0xee, 0xc9, 0x78, 0xee,
+	0xb0, 0x40, 0xda, 0x8e, 0x34, 0x36, 0x13, 0xc8, 0xf4, 0xe0, 0xea, 0x7c, 0x70, 0x3f, 0xbb, 0x7a,
+	0x75, 0x84, 0x69, 0xdd, 0x29, 0x5c, 0x5f, 0xe5, 0x1e, 0x03, 0xf6, 0xab, 0xf5, 0x16, 0x6d, 0x9a,
+	0x01, 0xe8, 0xc9, 0xdd, 0xf0, 0x84, 0xbd, 0xf0, 0x71, 0x26, 0xdc, 0x17, 0x71, 0x88, 0x42, 0xff,
+	0x1c, 0x76, 0x96, 0xc2, 0x9d, 0xcb, 0x38, 0xc4, 0x79, 0xc4, 0xfd, 0xa4, 0xa7, 0xf6, 0x68, 0xbb,
+	0x7c, 0xdf, 0x2b, 0x61, 0xd3, 0xea, 0x2d, 0xb3, 0xa3, 0x27, 0xdc, 0x17, 0x89, 0x74, 0x2c, 0x44,
+	0x6e, 0x4b, 0xa6, 0x24, 0x2f, 0x6c, 0xf3, 0x3d, 0x20, 0x37, 0xf9, 0x54, 0x35, 0x4b, 0xb8, 0x3b,
+	0x13, 0xee, 0x37, 0xc1, 0x1b, 0x2a, 0xe6, 0x00, 0x1e, 0xdc, 0xa0, 0x53, 0xb5, 0xfc, 0x9c, 0x5e,
+	0xae, 0x27, 0x1c, 0xf1, 0x37, 0x3c, 0x76, 0x1c, 0x16, 0x05, 0xf2, 0x96, 0x29, 0xef, 0x43, 0x87,
+	0xa3, 0x2d, 0xd4, 0x80, 0x73, 0xab, 0x42, 0xdf, 0xae, 0xd1, 0x13, 0x30, 0xea, 0x0c, 0x8a, 0xfd,
+	0xbb, 0x74, 0x2e, 0x27, 0xc1, 0xcb, 0x35, 0xf9, 0x57, 0x6b, 0x5e, 0xcb, 0x55, 0x30, 0x1d, 0xfd,
+	0xd5, 0x81, 0xf6, 0x4c, 0xb8, 0xfa, 0x33, 0xe8, 0xaa, 0xd7, 0xfc, 0xe1, 0xcd, 0x15, 0x2e, 0x3d,
+	0x60, 0xe4, 0xd1, 0xad, 0x61, 0xb5, 0x42, 0x3f, 0x42, 0xaf, 0xfc, 0x7e, 0x0d, 0x1b, 0x4f, 0x95,
+	0x10, 0x64, 0xb4, 0x0a, 0xa1, 0x52, 0x3f, 0x83, 0xae, 0x7a, 0x98, 0x9a, 0x8b, 0x2d, 0xc2, 0xe4,
+	0xd1, 0xad, 0x61, 0x95, 0x71, 0x0e, 0x3b, 0xd5, 0x87, 0xc4, 0x6c, 0x6e, 0xb2, 0x8c, 0x21, 0x8f,
+	0x57, 0x63, 0xca, 0x6a, 0x94, 0x1f, 0x8c, 0x66, 0x35, 0x4a, 0x08, 0x32, 0x5a, 0x85, 0x50, 0xa9,
+	0x11, 0xee, 0xd4, 0x37, 0xf8, 0x83, 0x66, 0x29, 0xab, 0x28, 0x72, 0xb8, 0x0e, 0x4a, 0xd1, 0x2c,
+	0x60, 0xb7, 0xb6, 0x9a, 0xef, 0x37, 0x9e, 0xaf, 0x82, 0xc8, 0x87, 0x6b, 0x80, 0xca, 0x63, 0xa8,
+	0xae, 0x5c, 0xf3, 0x18, 0x2a, 0x18, 0xf2, 0x78, 0x35, 0xa6, 0xac, 0x55, 0x7d, 0xab, 0x9a, 0xb5,
+	0xaa, 0xa1, 0xc8, 0xe1, 0x3a, 0xa8, 0x82, 0x66, 0xfa, 0xfd, 0x9f, 0x17, 0x7d, 0xed, 0xf5, 0x45,
+	0x5f, 0xfb, 0xf7, 0xa2, 0xaf, 0xbd, 0xba, 0xec, 0x6f, 0xbc, 0xbe, 0xec, 0x6f, 0xfc, 0x7d, 0xd9,
+	0xdf, 0xf8, 0xe9, 0x23, 0xd7, 0x93, 0x49, 0x16, 0x87, 0x2d, 0x27, 0x49, 0xc6, 0x00, 0xe5, 0x24,
+	0xcf, 0x3c, 0x59, 0x32, 0x1a, 0xf9, 0x28, 0x26, 0xd7, 0x7f, 0x74, 0x89, 0x38, 0x8b, 0x4e, 0xfa,
+	0xeb, 0xf2, 0xf1, 0x7f, 0x03, 0x00, 0x64, 0x98, 0x66, 0xff, 0xea, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddSuper defines a method for adding a super account
+	AddSuper(ctx context.Context, in *MsgAddSuper, opts ...grpc.CallOption) (*MsgAddSuperResponse, error)
+	// DeleteSuper defines a method for deleting a super account
+	DeleteSuper(ctx context.Context, in *MsgDeleteSuper, opts ...grpc.CallOption) (*MsgDeleteSuperResponse, error)
+	// SetRoles defines a method for setting the roles of a super account
+	SetRoles(ctx context.Context, in *MsgSetRoles, opts ...grpc.CallOption) (*MsgSetRolesResponse, error)
+	// ApproveAction defines a method for approving a pending action
+	ApproveAction(ctx context.Context, in *MsgApproveAction, opts ...grpc.CallOption) (*MsgApproveActionResponse, error)
+	// UpdateSuper defines a method for a super to update its description and contact metadata
+	UpdateSuper(ctx context.Context, in *MsgUpdateSuper, opts ...grpc.CallOption) (*MsgUpdateSuperResponse, error)
+	// DisableMsgTypes defines a method for a circuit breaker to disable msg types
+	DisableMsgTypes(ctx context.Context, in *MsgDisableMsgTypes, opts ...grpc.CallOption) (*MsgDisableMsgTypesResponse, error)
+	// EnableMsgTypes defines a method for a circuit breaker to re-enable disabled msg types
+	EnableMsgTypes(ctx context.Context, in *MsgEnableMsgTypes, opts ...grpc.CallOption) (*MsgEnableMsgTypesResponse, error)
+	// FreezeAccount defines a method for a blocklist admin to freeze an account
+	FreezeAccount(ctx context.Context, in *MsgFreezeAccount, opts ...grpc.CallOption) (*MsgFreezeAccountResponse, error)
+	// UnfreezeAccount defines a method for a blocklist admin to unfreeze a frozen account
+	UnfreezeAccount(ctx context.Context, in *MsgUnfreezeAccount, opts ...grpc.CallOption) (*MsgUnfreezeAccountResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddSuper(ctx context.Context, in *MsgAddSuper, opts ...grpc.CallOption) (*MsgAddSuperResponse, error) {
+	out := new(MsgAddSuperResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/AddSuper", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteSuper(ctx context.Context, in *MsgDeleteSuper, opts ...grpc.CallOption) (*MsgDeleteSuperResponse, error) {
+	out := new(MsgDeleteSuperResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/DeleteSuper", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRoles(ctx context.Context, in *MsgSetRoles, opts ...grpc.CallOption) (*MsgSetRolesResponse, error) {
+	out := new(MsgSetRolesResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/SetRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveAction(ctx context.Context, in *MsgApproveAction, opts ...grpc.CallOption) (*MsgApproveActionResponse, error) {
+	out := new(MsgApproveActionResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/ApproveAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSuper(ctx context.Context, in *MsgUpdateSuper, opts ...grpc.CallOption) (*MsgUpdateSuperResponse, error) {
+	out := new(MsgUpdateSuperResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/UpdateSuper", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableMsgTypes(ctx context.Context, in *MsgDisableMsgTypes, opts ...grpc.CallOption) (*MsgDisableMsgTypesResponse, error) {
+	out := new(MsgDisableMsgTypesResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/DisableMsgTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnableMsgTypes(ctx context.Context, in *MsgEnableMsgTypes, opts ...grpc.CallOption) (*MsgEnableMsgTypesResponse, error) {
+	out := new(MsgEnableMsgTypesResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/EnableMsgTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeAccount(ctx context.Context, in *MsgFreezeAccount, opts ...grpc.CallOption) (*MsgFreezeAccountResponse, error) {
+	out := new(MsgFreezeAccountResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/FreezeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeAccount(ctx context.Context, in *MsgUnfreezeAccount, opts ...grpc.CallOption) (*MsgUnfreezeAccountResponse, error) {
+	out := new(MsgUnfreezeAccountResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/UnfreezeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddSuper defines a method for adding a super account
+	AddSuper(context.Context, *MsgAddSuper) (*MsgAddSuperResponse, error)
+	// DeleteSuper defines a method for deleting a super account
+	DeleteSuper(context.Context, *MsgDeleteSuper) (*MsgDeleteSuperResponse, error)
+	// SetRoles defines a method for setting the roles of a super account
+	SetRoles(context.Context, *MsgSetRoles) (*MsgSetRolesResponse, error)
+	// ApproveAction defines a method for approving a pending action
+	ApproveAction(context.Context, *MsgApproveAction) (*MsgApproveActionResponse, error)
+	// UpdateSuper defines a method for a super to update its description and contact metadata
+	UpdateSuper(context.Context, *MsgUpdateSuper) (*MsgUpdateSuperResponse, error)
+	// DisableMsgTypes defines a method for a circuit breaker to disable msg types
+	DisableMsgTypes(context.Context, *MsgDisableMsgTypes) (*MsgDisableMsgTypesResponse, error)
+	// EnableMsgTypes defines a method for a circuit breaker to re-enable disabled msg types
+	EnableMsgTypes(context.Context, *MsgEnableMsgTypes) (*MsgEnableMsgTypesResponse, error)
+	// FreezeAccount defines a method for a blocklist admin to freeze an account
+	FreezeAccount(context.Context, *MsgFreezeAccount) (*MsgFreezeAccountResponse, error)
+	// UnfreezeAccount defines a method for a blocklist admin to unfreeze a frozen account
+	UnfreezeAccount(context.Context, *MsgUnfreezeAccount) (*MsgUnfreezeAccountResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddSuper(ctx context.Context, req *MsgAddSuper) (*MsgAddSuperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSuper not implemented")
+}
+func (*UnimplementedMsgServer) DeleteSuper(ctx context.Context, req *MsgDeleteSuper) (*MsgDeleteSuperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSuper not implemented")
+}
+func (*UnimplementedMsgServer) SetRoles(ctx context.Context, req *MsgSetRoles) (*MsgSetRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoles not implemented")
+}
+func (*UnimplementedMsgServer) ApproveAction(ctx context.Context, req *MsgApproveAction) (*MsgApproveActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAction not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSuper(ctx context.Context, req *MsgUpdateSuper) (*MsgUpdateSuperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSuper not implemented")
+}
+func (*UnimplementedMsgServer) DisableMsgTypes(ctx context.Context, req *MsgDisableMsgTypes) (*MsgDisableMsgTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMsgTypes not implemented")
+}
+func (*UnimplementedMsgServer) EnableMsgTypes(ctx context.Context, req *MsgEnableMsgTypes) (*MsgEnableMsgTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableMsgTypes not implemented")
+}
+func (*UnimplementedMsgServer) FreezeAccount(ctx context.Context, req *MsgFreezeAccount) (*MsgFreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeAccount(ctx context.Context, req *MsgUnfreezeAccount) (*MsgUnfreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddSuper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddSuper)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddSuper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/AddSuper",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddSuper(ctx, req.(*MsgAddSuper))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteSuper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteSuper)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteSuper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/DeleteSuper",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteSuper(ctx, req.(*MsgDeleteSuper))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRoles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/SetRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRoles(ctx, req.(*MsgSetRoles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/ApproveAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveAction(ctx, req.(*MsgApproveAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSuper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSuper)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSuper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/UpdateSuper",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSuper(ctx, req.(*MsgUpdateSuper))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableMsgTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableMsgTypes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableMsgTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/DisableMsgTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableMsgTypes(ctx, req.(*MsgDisableMsgTypes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnableMsgTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableMsgTypes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableMsgTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/EnableMsgTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableMsgTypes(ctx, req.(*MsgEnableMsgTypes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/FreezeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeAccount(ctx, req.(*MsgFreezeAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/UnfreezeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeAccount(ctx, req.(*MsgUnfreezeAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irishub.guardian.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddSuper",
+			Handler:    _Msg_AddSuper_Handler,
+		},
+		{
+			MethodName: "DeleteSuper",
+			Handler:    _Msg_DeleteSuper_Handler,
+		},
+		{
+			MethodName: "SetRoles",
+			Handler:    _Msg_SetRoles_Handler,
+		},
+		{
+			MethodName: "ApproveAction",
+			Handler:    _Msg_ApproveAction_Handler,
+		},
+		{
+			MethodName: "UpdateSuper",
+			Handler:    _Msg_UpdateSuper_Handler,
+		},
+		{
+			MethodName: "DisableMsgTypes",
+			Handler:    _Msg_DisableMsgTypes_Handler,
+		},
+		{
+			MethodName: "EnableMsgTypes",
+			Handler:    _Msg_EnableMsgTypes_Handler,
+		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _Msg_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _Msg_UnfreezeAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "guardian/tx.proto",
+}
+
+func (m *MsgAddSuper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSuper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSuper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiryTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Roles) > 0 {
+		dAtA3 := make([]byte, len(m.Roles)*10)
+		var j2 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddSuperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSuperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSuper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSuper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSuper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeletedBy) > 0 {
+		i -= len(m.DeletedBy)
+		copy(dAtA[i:], m.DeletedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSuperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSuperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SetBy) > 0 {
+		i -= len(m.SetBy)
+		copy(dAtA[i:], m.SetBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SetBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Roles) > 0 {
+		dAtA5 := make([]byte, len(m.Roles)*10)
+		var j4 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSuper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSuper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSuper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSuperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSuperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableMsgTypes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableMsgTypes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableMsgTypes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableMsgTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableMsgTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableMsgTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableMsgTypes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableMsgTypes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableMsgTypes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableMsgTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableMsgTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableMsgTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddSuper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgAddSuperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgDeleteSuper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeletedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteSuperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgSetRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.SetBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgApproveAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateSuper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateSuperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableMsgTypes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableMsgTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnableMsgTypes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnableMsgTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSuper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v Role
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Role(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]Role, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Role
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Role(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSuperResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSuper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteSuperResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSuperResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Role
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Role(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]Role, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Role
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Role(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSuper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSuper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSuperResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSuperResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableMsgTypes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableMsgTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableMsgTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableMsgTypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableMsgTypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableMsgTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgAddSuper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AddedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgEnableMsgTypes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableMsgTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableMsgTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAddSuperResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgDeleteSuper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DeletedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgEnableMsgTypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableMsgTypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableMsgTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDeleteSuperResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -590,15 +3927,15 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddSuper: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -626,11 +3963,11 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -658,11 +3995,11 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -690,7 +4027,7 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -716,7 +4053,7 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -739,10 +4076,10 @@ func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddSuperResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -769,7 +4106,7 @@ func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
+func (m *MsgUnfreezeAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -792,13 +4129,13 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSuper: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnfreezeAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnfreezeAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -830,9 +4167,9 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -860,7 +4197,7 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedBy = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -886,7 +4223,7 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSuperResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnfreezeAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -909,10 +4246,10 @@ func (m *MsgDeleteSuperResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSuperResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnfreezeAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnfreezeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
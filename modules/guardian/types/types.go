@@ -2,28 +2,226 @@ package types
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewSuper constructs a super
-func NewSuper(description string, accountType AccountType, address, addedBy sdk.AccAddress) Super {
+func NewSuper(description string, accountType AccountType, address, addedBy sdk.AccAddress, roles ...Role) Super {
 	return Super{
 		Description: description,
 		AccountType: accountType,
 		Address:     address.String(),
 		AddedBy:     addedBy.String(),
+		Roles:       roles,
 	}
 }
 
 // Equal returns if the guardian is equal to specified guardian
 func (g Super) Equal(super Super) bool {
+	if len(g.Roles) != len(super.Roles) {
+		return false
+	}
+	for i, role := range g.Roles {
+		if role != super.Roles[i] {
+			return false
+		}
+	}
+	if (g.ExpiryTime == nil) != (super.ExpiryTime == nil) ||
+		(g.ExpiryTime != nil && !g.ExpiryTime.Equal(*super.ExpiryTime)) {
+		return false
+	}
 	return g.Address == super.Address &&
 		g.AddedBy == super.AddedBy &&
 		g.Description == super.Description &&
-		g.AccountType == super.AccountType
+		g.Website == super.Website &&
+		g.SecurityContact == super.SecurityContact &&
+		g.AccountType == super.AccountType &&
+		g.ExpiryHeight == super.ExpiryHeight
+}
+
+// WithExpiry returns the super expiring at the time or height, whichever comes first.
+// A nil time or a zero height means no expiry.
+func (g Super) WithExpiry(expiryTime *time.Time, expiryHeight int64) Super {
+	g.ExpiryTime = expiryTime
+	g.ExpiryHeight = expiryHeight
+	return g
+}
+
+// UpdateMetadata returns the super with the description and contact metadata updated,
+// the values equal to DoNotModify are left unchanged
+func (g Super) UpdateMetadata(description, website, securityContact string) Super {
+	if description != DoNotModify {
+		g.Description = description
+	}
+	if website != DoNotModify {
+		g.Website = website
+	}
+	if securityContact != DoNotModify {
+		g.SecurityContact = securityContact
+	}
+	return g
+}
+
+// Expired returns true if the super has expired at the block time and height
+func (g Super) Expired(blockTime time.Time, blockHeight int64) bool {
+	return (g.ExpiryHeight > 0 && blockHeight >= g.ExpiryHeight) ||
+		(g.ExpiryTime != nil && !blockTime.Before(*g.ExpiryTime))
+}
+
+// ValidateExpiry checks that the expiry height is not negative
+func ValidateExpiry(expiryTime *time.Time, expiryHeight int64) error {
+	if expiryHeight < 0 {
+		return sdkerrors.Wrapf(ErrInvalidExpiry, "expiry height (%d) must not be negative", expiryHeight)
+	}
+	if expiryTime != nil && expiryTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExpiry, "expiry time must not be zero")
+	}
+	return nil
+}
+
+// HasRole returns true if the super is granted the specified role.
+// A genesis super holds all the roles.
+func (g Super) HasRole(role Role) bool {
+	if g.AccountType == Genesis {
+		return ValidRole(role)
+	}
+	for _, r := range g.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the stateless fields of the super
+func (g Super) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(g.AddedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "added by: %s", err)
+	}
+	if !ValidAccountType(g.AccountType) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type: %d", g.AccountType)
+	}
+	if err := ValidateRoles(g.Roles); err != nil {
+		return err
+	}
+	if err := ValidateExpiry(g.ExpiryTime, g.ExpiryHeight); err != nil {
+		return err
+	}
+	// the genesis supers never expire, so that the last of them can't be removed
+	if g.AccountType == Genesis && (g.ExpiryTime != nil || g.ExpiryHeight > 0) {
+		return sdkerrors.Wrap(ErrInvalidExpiry, "genesis super can't expire")
+	}
+	return nil
+}
+
+// KnownAdders returns the addresses which may have added the supers: the supers, the gov module
+// account, and the former supers and adders recorded in the audit log
+func KnownAdders(supers []Super, auditLog []AuditRecord) map[string]bool {
+	known := map[string]bool{
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(): true,
+	}
+	for _, super := range supers {
+		known[super.Address] = true
+	}
+	for _, record := range auditLog {
+		known[record.Target] = true
+		if len(record.Actor) > 0 {
+			known[record.Actor] = true
+		}
+	}
+	return known
+}
+
+// ValidateAdders checks that the ordinary supers are added by the known addresses
+func ValidateAdders(supers []Super, known map[string]bool) error {
+	for _, super := range supers {
+		if super.AccountType == Ordinary && !known[super.AddedBy] {
+			return sdkerrors.Wrapf(ErrUnknownOperator, "super %s is added by the unknown address %s", super.Address, super.AddedBy)
+		}
+	}
+	return nil
+}
+
+// ValidateGenesisSuperExists checks that a genesis super exists if any ordinary super does
+func ValidateGenesisSuperExists(supers []Super) error {
+	if len(supers) == 0 {
+		return nil
+	}
+	for _, super := range supers {
+		if super.AccountType == Genesis {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrNoGenesisSuper, "%d ordinary supers without a genesis super", len(supers))
+}
+
+// ValidateGenesisThreshold checks that there are enough genesis supers to meet the threshold
+// if any super exists, so that the pending actions can still be approved
+func ValidateGenesisThreshold(supers []Super, threshold uint32) error {
+	if len(supers) == 0 {
+		return nil
+	}
+	var count int
+	for _, super := range supers {
+		if super.AccountType == Genesis {
+			count++
+		}
+	}
+	if count < int(threshold) {
+		return sdkerrors.Wrapf(ErrGenesisThreshold, "%d genesis supers can't meet the threshold %d", count, threshold)
+	}
+	return nil
+}
+
+// SuperFilter defines the filters of the supers query
+type SuperFilter struct {
+	Role        Role
+	AccountType *AccountType
+	AddedBy     string
+}
+
+// NewSuperFilter constructs a SuperFilter, the empty arguments match any super
+func NewSuperFilter(role Role, accountType, addedBy string) (SuperFilter, error) {
+	filter := SuperFilter{Role: role}
+	if role != RoleUnspecified && !ValidRole(role) {
+		return filter, sdkerrors.Wrapf(ErrInvalidRole, "unknown role: %d", role)
+	}
+	if len(accountType) > 0 {
+		at, err := AccountTypeFromString(accountType)
+		if err != nil {
+			return filter, err
+		}
+		filter.AccountType = &at
+	}
+	if len(addedBy) > 0 {
+		if _, err := sdk.AccAddressFromBech32(addedBy); err != nil {
+			return filter, err
+		}
+		filter.AddedBy = addedBy
+	}
+	return filter, nil
+}
+
+// Match returns true if the super passes all the filters
+func (f SuperFilter) Match(super Super) bool {
+	if f.Role != RoleUnspecified && !super.HasRole(f.Role) {
+		return false
+	}
+	if f.AccountType != nil && super.AccountType != *f.AccountType {
+		return false
+	}
+	return len(f.AddedBy) == 0 || super.AddedBy == f.AddedBy
 }
 
 // AccountTypeFromString converts string to AccountType byte, Returns ff if invalid.
@@ -67,3 +265,64 @@ func (at AccountType) Format(s fmt.State, verb rune) {
 		s.Write([]byte(fmt.Sprintf("%v", byte(at))))
 	}
 }
+
+// Name returns the role name used by the CLI, e.g. "oracle-operator"
+func (r Role) Name() string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(r.String(), "ROLE_")), "_", "-")
+}
+
+// RoleFromString converts the role name to Role, e.g. "oracle-operator"
+func RoleFromString(str string) (Role, error) {
+	for value := range Role_name {
+		if role := Role(value); ValidRole(role) && role.Name() == str {
+			return role, nil
+		}
+	}
+	return RoleUnspecified, errors.Errorf("'%s' is not a valid role", str)
+}
+
+// RolesFromString converts the comma separated role names to Roles
+func RolesFromString(str string) ([]Role, error) {
+	var roles []Role
+	for _, name := range strings.Split(str, ",") {
+		if name = strings.TrimSpace(name); len(name) == 0 {
+			continue
+		}
+		role, err := RoleFromString(name)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// RolesString returns the comma separated role names
+func RolesString(roles []Role) string {
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = role.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+// ValidRole returns true if the Role option is valid and false otherwise.
+func ValidRole(role Role) bool {
+	_, ok := Role_name[int32(role)]
+	return ok && role != RoleUnspecified
+}
+
+// ValidateRoles checks that the roles are valid and not duplicated
+func ValidateRoles(roles []Role) error {
+	seen := make(map[Role]bool)
+	for _, role := range roles {
+		if !ValidRole(role) {
+			return sdkerrors.Wrapf(ErrInvalidRole, "unknown role: %d", role)
+		}
+		if seen[role] {
+			return sdkerrors.Wrapf(ErrInvalidRole, "duplicated role: %s", role.Name())
+		}
+		seen[role] = true
+	}
+	return nil
+}
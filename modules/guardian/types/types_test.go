@@ -0,0 +1,173 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestRoleFromString(t *testing.T) {
+	for _, role := range []Role{RoleOracleOperator, RoleServiceAdmin, RoleTokenAdmin, RoleUpgradeOperator, RoleCircuitBreaker} {
+		parsed, err := RoleFromString(role.Name())
+		require.NoError(t, err)
+		require.Equal(t, role, parsed)
+	}
+
+	require.Equal(t, "oracle-operator", RoleOracleOperator.Name())
+
+	_, err := RoleFromString("unspecified")
+	require.Error(t, err)
+	_, err = RoleFromString("ROLE_TOKEN_ADMIN")
+	require.Error(t, err)
+}
+
+func TestRolesFromString(t *testing.T) {
+	roles, err := RolesFromString("oracle-operator, token-admin")
+	require.NoError(t, err)
+	require.Equal(t, []Role{RoleOracleOperator, RoleTokenAdmin}, roles)
+	require.Equal(t, "oracle-operator,token-admin", RolesString(roles))
+
+	roles, err = RolesFromString("")
+	require.NoError(t, err)
+	require.Empty(t, roles)
+
+	_, err = RolesFromString("oracle-operator,admin")
+	require.Error(t, err)
+}
+
+func TestSuperHasRole(t *testing.T) {
+	genesis := NewSuper(description, Genesis, testAddr, testAddr)
+	require.True(t, genesis.HasRole(RoleOracleOperator))
+	require.True(t, genesis.HasRole(RoleUpgradeOperator))
+	require.False(t, genesis.HasRole(RoleUnspecified))
+
+	ordinary := NewSuper(description, Ordinary, testAddr, sender, RoleServiceAdmin)
+	require.True(t, ordinary.HasRole(RoleServiceAdmin))
+	require.False(t, ordinary.HasRole(RoleOracleOperator))
+
+	require.False(t, ordinary.Equal(NewSuper(description, Ordinary, testAddr, sender)))
+	require.True(t, ordinary.Equal(NewSuper(description, Ordinary, testAddr, sender, RoleServiceAdmin)))
+}
+
+func TestSuperExpired(t *testing.T) {
+	now := time.Unix(1600000000, 0).UTC()
+	expiry := now.Add(time.Hour)
+
+	super := NewSuper(description, Ordinary, testAddr, sender)
+	require.False(t, super.Expired(now, 100))
+
+	byHeight := super.WithExpiry(nil, 100)
+	require.False(t, byHeight.Expired(now, 99))
+	require.True(t, byHeight.Expired(now, 100))
+
+	byTime := super.WithExpiry(&expiry, 0)
+	require.False(t, byTime.Expired(now, 1000))
+	require.True(t, byTime.Expired(expiry, 1))
+
+	// whichever comes first
+	both := super.WithExpiry(&expiry, 100)
+	require.True(t, both.Expired(now, 100))
+	require.True(t, both.Expired(expiry, 1))
+
+	require.False(t, super.Equal(byTime))
+	require.True(t, byTime.Equal(super.WithExpiry(&expiry, 0)))
+
+	require.NoError(t, ValidateExpiry(&expiry, 100))
+	require.Error(t, ValidateExpiry(nil, -1))
+	require.Error(t, ValidateExpiry(&time.Time{}, 0))
+}
+
+func TestValidateGenesisExpiry(t *testing.T) {
+	expiry := time.Unix(1600000000, 0).UTC()
+	genesis := NewSuper(description, Genesis, sender, sender)
+
+	data := NewGenesisState([]Super{genesis, NewSuper(description, Ordinary, testAddr, sender).WithExpiry(&expiry, 100)}, DefaultParams(), nil, 1, nil, nil, nil)
+	require.NoError(t, ValidateGenesis(*data))
+
+	data = NewGenesisState([]Super{NewSuper(description, Genesis, testAddr, testAddr).WithExpiry(nil, 100)}, DefaultParams(), nil, 1, nil, nil, nil)
+	require.Error(t, ValidateGenesis(*data))
+
+	data = NewGenesisState([]Super{genesis, NewSuper(description, Ordinary, testAddr, sender).WithExpiry(nil, -1)}, DefaultParams(), nil, 1, nil, nil, nil)
+	require.Error(t, ValidateGenesis(*data))
+}
+
+func TestValidateGenesisSupers(t *testing.T) {
+	genesis := NewSuper(description, Genesis, sender, sender)
+	ordinary := NewSuper(description, Ordinary, testAddr, sender)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	formerAddr := sdk.AccAddress("former_super________")
+
+	invalidAddress := ordinary
+	invalidAddress.Address = "invalid"
+	invalidAddedBy := ordinary
+	invalidAddedBy.AddedBy = "invalid"
+	invalidType := ordinary
+	invalidType.AccountType = AccountType(2)
+
+	tests := []struct {
+		name       string
+		expectPass bool
+		supers     []Super
+		auditLog   []AuditRecord
+	}{
+		{"no supers", true, nil, nil},
+		{"genesis and ordinary supers", true, []Super{genesis, ordinary}, nil},
+		{"added by governance", true, []Super{genesis, NewSuper(description, Ordinary, testAddr, govAddr)}, nil},
+		{"added by a former super", true, []Super{genesis, NewSuper(description, Ordinary, testAddr, formerAddr)}, []AuditRecord{
+			NewAuditRecord(1, OperationDeleteSuper, sender.String(), formerAddr.String(), 10, time.Unix(1600000000, 0).UTC()),
+		}},
+		{"added by an unknown address", false, []Super{genesis, NewSuper(description, Ordinary, testAddr, formerAddr)}, nil},
+		{"duplicated super", false, []Super{genesis, ordinary, ordinary}, nil},
+		{"invalid address", false, []Super{genesis, invalidAddress}, nil},
+		{"invalid added by", false, []Super{genesis, invalidAddedBy}, nil},
+		{"invalid account type", false, []Super{genesis, invalidType}, nil},
+		{"no genesis super", false, []Super{NewSuper(description, Ordinary, testAddr, testAddr)}, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateGenesis(*NewGenesisState(tc.supers, DefaultParams(), nil, 1, tc.auditLog, nil, nil))
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	msgTypeURL := "/cosmos.bank.v1beta1.MsgSend"
+
+	testCases := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"fee exempt msg types", NewParams(1, time.Hour, []string{msgTypeURL}, sdk.NewDecWithPrec(5, 1), 10, RoleOracleOperator), true},
+		{"no fee exempt txs", NewParams(1, time.Hour, []string{msgTypeURL}, sdk.ZeroDec(), 0, RoleOracleOperator), true},
+		{"zero genesis threshold", NewParams(0, time.Hour, nil, sdk.OneDec(), 10, RoleOracleOperator), false},
+		{"zero pending action timeout", NewParams(1, 0, nil, sdk.OneDec(), 10, RoleOracleOperator), false},
+		{"invalid fee exempt msg type", NewParams(1, time.Hour, []string{"MsgSend"}, sdk.OneDec(), 10, RoleOracleOperator), false},
+		{"duplicated fee exempt msg type", NewParams(1, time.Hour, []string{msgTypeURL, msgTypeURL}, sdk.OneDec(), 10, RoleOracleOperator), false},
+		{"nil fee discount", NewParams(1, time.Hour, nil, sdk.Dec{}, 10, RoleOracleOperator), false},
+		{"negative fee discount", NewParams(1, time.Hour, nil, sdk.NewDec(-1), 10, RoleOracleOperator), false},
+		{"fee discount above one", NewParams(1, time.Hour, nil, sdk.NewDecWithPrec(11, 1), 10, RoleOracleOperator), false},
+		{"unspecified fee exempt role", NewParams(1, time.Hour, nil, sdk.OneDec(), 10, RoleUnspecified), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
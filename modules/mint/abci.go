@@ -1,6 +1,8 @@
 package mint
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/irisnet/irishub/modules/mint/keeper"
@@ -21,9 +23,10 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	// Calculate block mint amount
 	params := k.GetParamSet(ctx)
-	logger.Info("Mint parameters", "inflation_rate", params.Inflation.String(), "mint_denom", params.MintDenom)
+	inflation := k.NextInflation(ctx, minter, params)
+	logger.Info("Mint parameters", "inflation_mode", params.Mode.String(), "inflation_rate", inflation.String(), "mint_denom", params.MintDenom)
 
-	mintedCoin := minter.BlockProvision(params)
+	mintedCoin, maxSupplyReached := k.ClampToMaxSupply(ctx, minter.BlockProvision(params, inflation, blockTime))
 	logger.Info("Mint result", "block_provisions", mintedCoin.String(), "time", blockTime.String())
 
 	mintedCoins := sdk.NewCoins(mintedCoin)
@@ -32,22 +35,41 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	if err := k.AddCollectedFees(ctx, mintedCoins); err != nil {
+	// distribute the minted coins to the distribution targets
+	targets, shares, err := k.DistributeMintedCoins(ctx, params.Distribution, mintedCoins)
+	if err != nil {
 		panic(err)
 	}
 
 	// Update last block BFT time
 	lastInflationTime := minter.LastUpdate
 	minter.LastUpdate = blockTime
+	minter.Inflation = inflation
 	k.SetMinter(ctx, minter)
+	k.SetBlockProvision(ctx, mintedCoin)
+	k.RecordIssuance(ctx, params, mintedCoins)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeMint,
-			sdk.NewAttribute(types.AttributeKeyLastInflationTime, lastInflationTime.String()),
-			sdk.NewAttribute(types.AttributeKeyInflationTime, blockTime.String()),
-			sdk.NewAttribute(types.AttributeKeyMintCoin, mintedCoin.Amount.String()),
-		),
+	event := sdk.NewEvent(
+		types.EventTypeMint,
+		sdk.NewAttribute(types.AttributeKeyLastInflationTime, lastInflationTime.String()),
+		sdk.NewAttribute(types.AttributeKeyInflationTime, blockTime.String()),
+		sdk.NewAttribute(types.AttributeKeyMintCoin, mintedCoin.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyInflation, inflation.String()),
 	)
+	for i, t := range targets {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyShare, fmt.Sprintf("%s:%s", t.Target, shares[i])))
+	}
+	ctx.EventManager().EmitEvent(event)
+
+	if maxSupplyReached {
+		maxSupply, _ := k.GetMaxSupply(ctx, params.MintDenom)
+		logger.Info("Max supply reached", "denom", params.MintDenom, "max_supply", maxSupply.String())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMaxSupplyReached,
+				sdk.NewAttribute(types.AttributeKeyDenom, params.MintDenom),
+				sdk.NewAttribute(types.AttributeKeyMaxSupply, maxSupply.String()),
+			),
+		)
+	}
 }
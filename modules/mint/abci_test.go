@@ -2,6 +2,7 @@ package mint_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -10,6 +11,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
 
 	"github.com/irisnet/irishub/modules/mint"
 	"github.com/irisnet/irishub/modules/mint/types"
@@ -19,14 +23,208 @@ import (
 func TestBeginBlocker(t *testing.T) {
 	app, ctx := createTestApp(true)
 
-	mint.BeginBlocker(ctx, app.MintKeeper)
 	minter := app.MintKeeper.GetMinter(ctx)
 	param := app.MintKeeper.GetParamSet(ctx)
-	mintCoins := minter.BlockProvision(param)
+	mintCoins := minter.BlockProvision(param, param.Inflation, ctx.BlockTime())
+
+	mint.BeginBlocker(ctx, app.MintKeeper)
 
 	acc1 := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
 	mintedCoins := app.BankKeeper.GetAllBalances(ctx, acc1.GetAddress())
 	require.Equal(t, mintedCoins, sdk.NewCoins(mintCoins))
+	require.Equal(t, ctx.BlockTime(), app.MintKeeper.GetMinter(ctx).LastUpdate)
+	require.Equal(t, mintCoins, app.MintKeeper.GetBlockProvision(ctx))
+}
+
+func TestBeginBlockerVariedBlockIntervals(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	blockTime := time.Unix(1600000000, 0).UTC()
+	app.MintKeeper.SetMinter(ctx, types.NewMinter(blockTime, types.DefaultMinter().InflationBase))
+
+	param := app.MintKeeper.GetParamSet(ctx)
+	annualProvisions := app.MintKeeper.GetMinter(ctx).NextAnnualProvisions(param.Inflation)
+	perSecond := annualProvisions.QuoInt64(60 * 60 * 8766)
+
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector").GetAddress()
+	expected := sdk.ZeroInt()
+
+	intervals := []time.Duration{
+		5 * time.Second,
+		time.Second,
+		7 * time.Second,
+		30 * time.Second,
+		500 * time.Millisecond,
+		3 * time.Second,
+	}
+	for i, interval := range intervals {
+		blockTime = blockTime.Add(interval)
+		ctx = ctx.WithBlockHeight(int64(i + 2)).WithBlockTime(blockTime)
+		mint.BeginBlocker(ctx, app.MintKeeper)
+
+		seconds := sdk.NewDec(int64(interval)).QuoInt64(int64(time.Second))
+		expected = expected.Add(perSecond.Mul(seconds).TruncateInt())
+
+		balance := app.BankKeeper.GetBalance(ctx, feeCollector, param.MintDenom)
+		require.Equal(t, expected, balance.Amount, "block %d", i)
+		require.Equal(t, blockTime, app.MintKeeper.GetMinter(ctx).LastUpdate)
+	}
+
+	// a long halt only mints provisions for the max provision duration
+	blockTime = blockTime.Add(72 * time.Hour)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(blockTime)
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	seconds := sdk.NewDec(int64(param.MaxProvisionDuration)).QuoInt64(int64(time.Second))
+	expected = expected.Add(perSecond.Mul(seconds).TruncateInt())
+
+	balance := app.BankKeeper.GetBalance(ctx, feeCollector, param.MintDenom)
+	require.Equal(t, expected, balance.Amount)
+	require.Equal(t, blockTime, app.MintKeeper.GetMinter(ctx).LastUpdate)
+
+	// all the blocks are recorded in the issuance of a single epoch
+	issuances := app.MintKeeper.GetIssuances(ctx)
+	require.Len(t, issuances, 1)
+	require.Equal(t, int64(2), issuances[0].StartHeight)
+	require.Equal(t, ctx.BlockHeight(), issuances[0].EndHeight)
+	require.Equal(t, blockTime, issuances[0].EndTime)
+	require.Equal(t, expected, issuances[0].Amount.AmountOf(param.MintDenom))
+}
+
+func TestBeginBlockerSchedule(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	blockTime := time.Unix(1600000000, 0).UTC()
+	app.MintKeeper.SetMinter(ctx, types.NewMinter(blockTime, types.DefaultMinter().InflationBase))
+
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.Schedule = []types.InflationStep{
+		types.NewInflationStep(nil, 3, sdk.NewDecWithPrec(10, 2), sdk.ZeroDec()),
+	}
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector").GetAddress()
+	for height, inflation := range map[int64]sdk.Dec{2: params.Inflation, 3: sdk.NewDecWithPrec(10, 2)} {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(blockTime.Add(5 * time.Second))
+		app.MintKeeper.SetMinter(ctx, types.NewMinter(blockTime, types.DefaultMinter().InflationBase))
+		balance := app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom)
+
+		expected := app.MintKeeper.GetMinter(ctx).BlockProvision(params, inflation, ctx.BlockTime())
+		mint.BeginBlocker(ctx, app.MintKeeper)
+
+		require.Equal(t, balance.Add(expected), app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom))
+		require.Equal(t, inflation, app.MintKeeper.GetMinter(ctx).Inflation)
+	}
+}
+
+func TestBeginBlockerBondedRatio(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	blockTime := time.Unix(1600000000, 0).UTC()
+	minter := types.NewMinter(blockTime, types.DefaultMinter().InflationBase)
+	minter.Inflation = sdk.NewDecWithPrec(10, 2)
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.Mode = types.BondedRatio
+	app.MintKeeper.SetParamSet(ctx, params)
+	app.StakingKeeper.SetParams(ctx, stakingtypes.DefaultParams())
+
+	// nothing is bonded in the test app, so the rate moves toward the max
+	require.True(t, app.StakingKeeper.BondedRatio(ctx).IsZero())
+
+	ctx = ctx.WithBlockTime(blockTime.Add(5 * time.Second))
+	expected := minter.NextInflationRate(params, sdk.ZeroDec(), ctx.BlockTime())
+	require.True(t, expected.GT(minter.Inflation))
+
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector").GetAddress()
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	require.Equal(t, expected, app.MintKeeper.GetMinter(ctx).Inflation)
+	require.Equal(t,
+		minter.BlockProvision(params, expected, ctx.BlockTime()),
+		app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom),
+	)
+}
+
+func TestBeginBlockerDistribution(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	blockTime := time.Unix(1600000000, 0).UTC()
+	app.MintKeeper.SetMinter(ctx, types.NewMinter(blockTime, types.DefaultMinter().InflationBase))
+
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.Distribution = []types.DistributionTarget{
+		types.NewDistributionTarget("fee_collector", sdk.NewDecWithPrec(6, 1)),
+		types.NewDistributionTarget(types.CommunityPoolTarget, sdk.NewDecWithPrec(4, 1)),
+	}
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(5 * time.Second)).WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	minted := app.MintKeeper.GetBlockProvision(ctx)
+	shares := types.Split(params.Distribution, sdk.NewCoins(minted))
+
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector").GetAddress()
+	require.Equal(t, shares[0], app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(shares[1]...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	var attributes []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeMint {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyShare {
+				attributes = append(attributes, string(attr.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{
+		"fee_collector:" + shares[0].String(),
+		types.CommunityPoolTarget + ":" + shares[1].String(),
+	}, attributes)
+}
+
+func TestBeginBlockerMaxSupply(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	token := tokentypes.NewToken(sdk.DefaultBondDenom, "Network staking token", sdk.DefaultBondDenom, 0, 1000, 10000000000, true, sdk.AccAddress("owner"))
+	require.NoError(t, app.TokenKeeper.AddToken(ctx, token))
+
+	maxSupply := sdk.NewInt(10000000000)
+	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, maxSupply.SubRaw(10)))))
+
+	blockTime := time.Unix(1600000000, 0).UTC()
+	app.MintKeeper.SetMinter(ctx, types.NewMinter(blockTime, types.DefaultMinter().InflationBase))
+
+	// the provision is clamped to the max supply
+	blockTime = blockTime.Add(5 * time.Second)
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)), app.MintKeeper.GetBlockProvision(ctx))
+	require.Equal(t, maxSupply, app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultBondDenom))
+	require.True(t, hasEvent(ctx, types.EventTypeMaxSupplyReached))
+
+	// nothing is minted once the max supply is reached
+	blockTime = blockTime.Add(5 * time.Second)
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	require.True(t, app.MintKeeper.GetBlockProvision(ctx).IsZero())
+	require.Equal(t, maxSupply, app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultBondDenom))
+	require.False(t, hasEvent(ctx, types.EventTypeMaxSupplyReached))
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
 }
 
 // returns context and an app with updated mint keeper
@@ -37,6 +235,7 @@ func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	app.MintKeeper.SetParamSet(ctx, types.NewParams(
 		sdk.DefaultBondDenom,
 		sdk.NewDecWithPrec(4, 2),
+		nil,
 	))
 	app.MintKeeper.SetMinter(ctx, types.DefaultMinter())
 	app.BankKeeper.SetSupply(ctx, &banktypes.Supply{})
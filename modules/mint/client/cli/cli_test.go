@@ -1,12 +1,17 @@
 package cli_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 
+	mintcli "github.com/irisnet/irishub/modules/mint/client/cli"
 	minttestutil "github.com/irisnet/irishub/modules/mint/client/testutil"
 	minttypes "github.com/irisnet/irishub/modules/mint/types"
 	"github.com/irisnet/irishub/simapp"
@@ -52,4 +57,83 @@ func (s *IntegrationTestSuite) TestMint() {
 	params := respType.(*minttypes.Params)
 	s.Require().Equal("stake", params.MintDenom)
 	s.Require().Equal("0.040000000000000000", params.Inflation.String())
+
+	//------test GetCmdQueryMinter()-------------
+	minterType := proto.Message(&minttypes.Minter{})
+	bz, err = minttestutil.QueryMinterExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), minterType))
+	minter := minterType.(*minttypes.Minter)
+	s.Require().Equal(minttypes.DefaultMinter().InflationBase, minter.InflationBase)
+
+	//------test GetCmdQueryInflation()-------------
+	inflationType := proto.Message(&minttypes.QueryInflationResponse{})
+	bz, err = minttestutil.QueryInflationExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), inflationType))
+	inflationResp := inflationType.(*minttypes.QueryInflationResponse)
+	s.Require().Equal(minter.Inflation, inflationResp.Inflation)
+
+	//------test GetCmdQueryAnnualProvisions()-------------
+	annualType := proto.Message(&minttypes.QueryAnnualProvisionsResponse{})
+	bz, err = minttestutil.QueryAnnualProvisionsExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), annualType))
+	annualResp := annualType.(*minttypes.QueryAnnualProvisionsResponse)
+	s.Require().Equal(minter.NextAnnualProvisions(minter.Inflation), annualResp.AnnualProvisions)
+
+	//------test GetCmdQueryBlockProvision()-------------
+	provisionType := proto.Message(&sdk.Coin{})
+	bz, err = minttestutil.QueryBlockProvisionExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), provisionType))
+	provision := provisionType.(*sdk.Coin)
+	s.Require().Equal("stake", provision.Denom)
+
+	//------test GetCmdQueryIssuances()-------------
+	issuancesType := proto.Message(&minttypes.QueryIssuancesResponse{})
+	bz, err = minttestutil.QueryIssuancesExec(val.ClientCtx, fmt.Sprintf("--%s=2", mintcli.FlagStartHeight))
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), issuancesType))
+	issuancesResp := issuancesType.(*minttypes.QueryIssuancesResponse)
+	s.Require().Len(issuancesResp.Issuances, 1)
+	s.Require().Equal(int64(2), issuancesResp.Issuances[0].StartHeight)
+
+	_, err = minttestutil.QueryIssuancesExec(val.ClientCtx, fmt.Sprintf("--%s=yesterday", mintcli.FlagStartTime))
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestSubmitMintBaseUpdateProposal() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	txArgs := []string{
+		fmt.Sprintf("--%s=%s", govcli.FlagTitle, "Mint base update"),
+		fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Rebase the inflation on the current supply"),
+		fmt.Sprintf("--%s=%s", govcli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)).String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	//------test GetCmdSubmitMintBaseUpdateProposal()-------------
+	respType := proto.Message(&sdk.TxResponse{})
+	bz, err := minttestutil.SubmitMintBaseUpdateProposalExec(clientCtx, val.Address.String(), append(txArgs, "1000000")...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(uint32(0), respType.(*sdk.TxResponse).Code)
+
+	respType = proto.Message(&sdk.TxResponse{})
+	bz, err = minttestutil.SubmitMintBaseUpdateProposalExec(clientCtx, val.Address.String(), append(txArgs, fmt.Sprintf("--%s", mintcli.FlagFromSupply))...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	s.Require().Equal(uint32(0), respType.(*sdk.TxResponse).Code)
+
+	// the inflation base and --from-supply are exclusive
+	_, err = minttestutil.SubmitMintBaseUpdateProposalExec(clientCtx, val.Address.String(), append(txArgs, "1000000", fmt.Sprintf("--%s", mintcli.FlagFromSupply))...)
+	s.Require().Error(err)
+
+	// either of them is required
+	_, err = minttestutil.SubmitMintBaseUpdateProposalExec(clientCtx, val.Address.String(), txArgs...)
+	s.Require().Error(err)
 }
@@ -0,0 +1,10 @@
+// nolint
+package cli
+
+const (
+	FlagFromSupply  = "from-supply"
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+	FlagStartTime   = "start-time"
+	FlagEndTime     = "end-time"
+)
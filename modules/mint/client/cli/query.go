@@ -2,11 +2,14 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 )
@@ -22,6 +25,11 @@ func GetQueryCmd() *cobra.Command {
 	}
 	mintingQueryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQueryMinter(),
+		GetCmdQueryInflation(),
+		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryBlockProvision(),
+		GetCmdQueryIssuances(),
 	)
 	return mintingQueryCmd
 }
@@ -52,3 +60,183 @@ func GetCmdQueryParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryMinter implements a command to return the current minter.
+func GetCmdQueryMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minter",
+		Short: "Query the current minter",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Minter(context.Background(), &types.QueryMinterRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Minter)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryInflation implements a command to return the current inflation rate.
+func GetCmdQueryInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation",
+		Short: "Query the current inflation rate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Inflation(context.Background(), &types.QueryInflationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAnnualProvisions implements a command to return the current annual provisions.
+func GetCmdQueryAnnualProvisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annual-provisions",
+		Short: "Query the current annual provisions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AnnualProvisions(context.Background(), &types.QueryAnnualProvisionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBlockProvision implements a command to return the provision minted in the current block.
+func GetCmdQueryBlockProvision() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-provision",
+		Short: "Query the provision minted in the current block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockProvision(context.Background(), &types.QueryBlockProvisionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.BlockProvision)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryIssuances implements a command to return the issuance ledger within a height and time range.
+func GetCmdQueryIssuances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuances",
+		Short: "Query the coins minted per epoch within a height and time range",
+		Example: fmt.Sprintf(
+			"%s query mint issuances --start-height=<start-height> --end-height=<end-height> --start-time=2020-12-01T00:00:00Z --end-time=2020-12-31T00:00:00Z",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			startHeight, _ := cmd.Flags().GetInt64(FlagStartHeight)
+			endHeight, _ := cmd.Flags().GetInt64(FlagEndHeight)
+			startTime, err := parseTimeFlag(cmd, FlagStartTime)
+			if err != nil {
+				return err
+			}
+			endTime, err := parseTimeFlag(cmd, FlagEndTime)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Issuances(context.Background(), &types.QueryIssuancesRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				StartTime:   startTime,
+				EndTime:     endTime,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	cmd.Flags().Int64(FlagStartHeight, 0, "skip the epochs ending before the height")
+	cmd.Flags().Int64(FlagEndHeight, 0, "skip the epochs starting after the height")
+	cmd.Flags().String(FlagStartTime, "", "skip the epochs ending before the time, in RFC3339 format")
+	cmd.Flags().String(FlagEndTime, "", "skip the epochs starting after the time, in RFC3339 format")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "issuances")
+	return cmd
+}
+
+// parseTimeFlag returns the RFC3339 time of the given flag, or nil if it is not set
+func parseTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, _ := cmd.Flags().GetString(flag)
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %s", flag, err)
+	}
+	return &t, nil
+}
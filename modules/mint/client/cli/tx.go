@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// GetCmdSubmitMintBaseUpdateProposal implements the command to submit a mint base update proposal
+func GetCmdSubmitMintBaseUpdateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-base-update [inflation-base]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Submit a proposal to update the inflation base of the minter",
+		Long: "Submit a proposal to update the inflation base of the minter along with an initial deposit.\n" +
+			"Either specify the new inflation base, or use --from-supply to derive it from the supply of the mint denom when the proposal passes.",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal mint-base-update 2000000000000000 --title=<title> --description=<description> --deposit=1000iris --from=<key-name> --chain-id=<chain-id> --fees=0.3iris",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			description, _ := cmd.Flags().GetString(govcli.FlagDescription)
+			fromSupply, _ := cmd.Flags().GetBool(FlagFromSupply)
+
+			var content *types.MintBaseUpdateProposal
+			switch {
+			case fromSupply && len(args) > 0:
+				return fmt.Errorf("inflation base must not be specified with --%s", FlagFromSupply)
+			case fromSupply:
+				content = types.NewMintBaseUpdateFromSupplyProposal(title, description)
+			case len(args) == 0:
+				return fmt.Errorf("either the inflation base or --%s must be specified", FlagFromSupply)
+			default:
+				inflationBase, ok := sdk.NewIntFromString(args[0])
+				if !ok {
+					return fmt.Errorf("invalid inflation base: %s", args[0])
+				}
+				content = types.NewMintBaseUpdateProposal(title, description, inflationBase)
+			}
+
+			depositStr, _ := cmd.Flags().GetString(govcli.FlagDeposit)
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(FlagFromSupply, false, "derive the inflation base from the supply of the mint denom when the proposal passes")
+	return cmd
+}
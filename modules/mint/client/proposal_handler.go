@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/irisnet/irishub/modules/mint/client/cli"
+	"github.com/irisnet/irishub/modules/mint/client/rest"
+)
+
+// ProposalHandler is the mint base update proposal handler.
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitMintBaseUpdateProposal, rest.ProposalRESTHandler)
+)
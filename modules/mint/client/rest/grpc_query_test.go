@@ -55,4 +55,50 @@ func (s *IntegrationTestSuite) TestHtlc() {
 	paramsResp := respType.(*minttypes.QueryParamsResponse)
 	s.Require().Equal("stake", paramsResp.Params.MintDenom)
 	s.Require().Equal("0.040000000000000000", paramsResp.Params.Inflation.String())
+
+	//------test GetCmdQueryMinter()-------------
+	url = fmt.Sprintf("%s/irishub/mint/minter", baseURL)
+	resp, err = rest.GetRequest(url)
+	respType = proto.Message(&minttypes.QueryMinterResponse{})
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, respType))
+	minterResp := respType.(*minttypes.QueryMinterResponse)
+	s.Require().Equal(minttypes.DefaultMinter().InflationBase, minterResp.Minter.InflationBase)
+
+	//------test GetCmdQueryInflation()-------------
+	url = fmt.Sprintf("%s/irishub/mint/inflation", baseURL)
+	resp, err = rest.GetRequest(url)
+	respType = proto.Message(&minttypes.QueryInflationResponse{})
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, respType))
+	inflationResp := respType.(*minttypes.QueryInflationResponse)
+	s.Require().Equal(minterResp.Minter.Inflation, inflationResp.Inflation)
+
+	//------test GetCmdQueryAnnualProvisions()-------------
+	url = fmt.Sprintf("%s/irishub/mint/annual_provisions", baseURL)
+	resp, err = rest.GetRequest(url)
+	respType = proto.Message(&minttypes.QueryAnnualProvisionsResponse{})
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, respType))
+	annualResp := respType.(*minttypes.QueryAnnualProvisionsResponse)
+	s.Require().Equal(minterResp.Minter.NextAnnualProvisions(minterResp.Minter.Inflation), annualResp.AnnualProvisions)
+
+	//------test GetCmdQueryBlockProvision()-------------
+	url = fmt.Sprintf("%s/irishub/mint/block_provision", baseURL)
+	resp, err = rest.GetRequest(url)
+	respType = proto.Message(&minttypes.QueryBlockProvisionResponse{})
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, respType))
+	blockResp := respType.(*minttypes.QueryBlockProvisionResponse)
+	s.Require().Equal("stake", blockResp.BlockProvision.Denom)
+
+	//------test GetCmdQueryIssuances()-------------
+	url = fmt.Sprintf("%s/irishub/mint/issuances?start_height=2&end_time=%s", baseURL, "2100-01-01T00:00:00Z")
+	resp, err = rest.GetRequest(url)
+	respType = proto.Message(&minttypes.QueryIssuancesResponse{})
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, respType), string(resp))
+	issuancesResp := respType.(*minttypes.QueryIssuancesResponse)
+	s.Require().Len(issuancesResp.Issuances, 1)
+	s.Require().Equal(int64(2), issuancesResp.Issuances[0].StartHeight)
 }
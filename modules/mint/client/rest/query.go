@@ -3,6 +3,8 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -14,13 +16,23 @@ import (
 
 func registerQueryRoutes(cliCtx client.Context, r *mux.Router) {
 	// get the current mint parameter values
-	r.HandleFunc(fmt.Sprintf("/%s/params", types.ModuleName), queryParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/params", types.ModuleName), queryHandlerFn(cliCtx, types.QueryParameters)).Methods("GET")
+	// get the current minter
+	r.HandleFunc(fmt.Sprintf("/%s/minter", types.ModuleName), queryHandlerFn(cliCtx, types.QueryMinter)).Methods("GET")
+	// get the current inflation rate
+	r.HandleFunc(fmt.Sprintf("/%s/inflation", types.ModuleName), queryHandlerFn(cliCtx, types.QueryInflation)).Methods("GET")
+	// get the current annual provisions
+	r.HandleFunc(fmt.Sprintf("/%s/annual-provisions", types.ModuleName), queryHandlerFn(cliCtx, types.QueryAnnualProvisions)).Methods("GET")
+	// get the provision minted in the current block
+	r.HandleFunc(fmt.Sprintf("/%s/block-provision", types.ModuleName), queryHandlerFn(cliCtx, types.QueryBlockProvision)).Methods("GET")
+	// get the issuance ledger within a height and time range
+	r.HandleFunc(fmt.Sprintf("/%s/issuances", types.ModuleName), queryIssuancesHandlerFn(cliCtx)).Methods("GET")
 }
 
-// HTTP request handler to get the current mint parameter values
-func queryParamsHandlerFn(cliCtx client.Context) http.HandlerFunc {
+// HTTP request handler to query the given mint querier path
+func queryHandlerFn(cliCtx client.Context, path string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
 
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
@@ -37,3 +49,63 @@ func queryParamsHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query the issuance ledger
+func queryIssuancesHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		params := types.QueryIssuancesParams{Page: page, Limit: limit}
+		if v := r.FormValue("start_height"); len(v) != 0 {
+			if params.StartHeight, err = strconv.ParseInt(v, 10, 64); rest.CheckBadRequestError(w, err) {
+				return
+			}
+		}
+		if v := r.FormValue("end_height"); len(v) != 0 {
+			if params.EndHeight, err = strconv.ParseInt(v, 10, 64); rest.CheckBadRequestError(w, err) {
+				return
+			}
+		}
+		if params.StartTime, err = parseTime(r.FormValue("start_time")); rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if params.EndTime, err = parseTime(r.FormValue("end_time")); rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryIssuances)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// parseTime returns the given RFC3339 time, or nil if it is empty
+func parseTime(value string) (*time.Time, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
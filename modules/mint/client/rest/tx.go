@@ -0,0 +1,63 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// MintBaseUpdateProposalReq defines the properties of a mint base update proposal request's body.
+type MintBaseUpdateProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title         string         `json:"title" yaml:"title"`
+	Description   string         `json:"description" yaml:"description"`
+	InflationBase sdk.Int        `json:"inflation_base" yaml:"inflation_base"`
+	FromSupply    bool           `json:"from_supply" yaml:"from_supply"`
+	Proposer      sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit       sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the mint base update REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "mint_base_update",
+		Handler:  postProposalHandlerFn(cliCtx),
+	}
+}
+
+func postProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MintBaseUpdateProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewMintBaseUpdateProposal(req.Title, req.Description, req.InflationBase)
+		if req.FromSupply {
+			content = types.NewMintBaseUpdateFromSupplyProposal(req.Title, req.Description)
+		}
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 
@@ -20,3 +21,61 @@ func QueryParamsExec(clientCtx client.Context, extraArgs ...string) (testutil.Bu
 
 	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryParams(), args)
 }
+
+func QueryMinterExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryMinter(), args)
+}
+
+func QueryInflationExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryInflation(), args)
+}
+
+func QueryAnnualProvisionsExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryAnnualProvisions(), args)
+}
+
+func QueryBlockProvisionExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryBlockProvision(), args)
+}
+
+func QueryIssuancesExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryIssuances(), args)
+}
+
+func SubmitMintBaseUpdateProposalExec(clientCtx client.Context, from string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+	}
+	args = append(args, extraArgs...)
+
+	// tx flags are added by the gov submit-proposal command
+	cmd := mintcli.GetCmdSubmitMintBaseUpdateProposal()
+	flags.AddTxFlagsToCmd(cmd)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+}
@@ -15,15 +15,23 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 	if err := ValidateGenesis(data); err != nil {
 		panic(fmt.Errorf("failed to initialize mint genesis state: %s", err.Error()))
 	}
+	if err := keeper.ValidateDistribution(data.Params.Distribution); err != nil {
+		panic(fmt.Errorf("failed to initialize mint genesis state: %s", err.Error()))
+	}
 	keeper.SetMinter(ctx, data.Minter)
+	keeper.SetInflationBase(ctx, data.Minter.InflationBase)
 	keeper.SetParamSet(ctx, data.Params)
+	for _, issuance := range data.Issuances {
+		keeper.SetIssuance(ctx, issuance)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParamSet(ctx)
-	return types.NewGenesisState(minter, params)
+	issuances := keeper.GetIssuances(ctx)
+	return types.NewGenesisState(minter, params, issuances)
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
@@ -32,5 +40,8 @@ func ValidateGenesis(data types.GenesisState) error {
 	if !data.Minter.InflationBase.IsPositive() {
 		return errors.New("base inflation must be positive")
 	}
+	if err := types.ValidateIssuances(data.Issuances); err != nil {
+		return err
+	}
 	return data.Params.Validate()
 }
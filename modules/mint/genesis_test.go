@@ -2,6 +2,7 @@ package mint_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -40,3 +41,38 @@ func (suite *TestSuite) TestExportGenesis() {
 	exportedGenesis := mint.ExportGenesis(suite.ctx, suite.app.MintKeeper)
 	suite.Equal(defaultGenesis, exportedGenesis)
 }
+
+func (suite *TestSuite) TestInitExportGenesisSchedule() {
+	startTime := time.Unix(1600000000, 0).UTC()
+	laterTime := startTime.Add(time.Hour)
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params.Schedule = []types.InflationStep{
+		types.NewInflationStep(&startTime, 0, sdk.NewDecWithPrec(10, 2), sdk.ZeroDec()),
+		types.NewInflationStep(&laterTime, 0, sdk.NewDecWithPrec(8, 2), sdk.NewDecWithPrec(5, 2)),
+	}
+
+	mint.InitGenesis(suite.ctx, suite.app.MintKeeper, *genesis)
+	exportedGenesis := mint.ExportGenesis(suite.ctx, suite.app.MintKeeper)
+	suite.Equal(genesis, exportedGenesis)
+}
+
+func (suite *TestSuite) TestInitExportGenesisIssuances() {
+	startTime := time.Unix(1600000000, 0).UTC()
+	coins := sdk.NewCoins(sdk.NewCoin(types.MintDenom, sdk.NewInt(1000)))
+
+	first := types.NewIssuance(2, startTime, coins)
+	first.EndHeight = 11
+	first.EndTime = startTime.Add(time.Minute)
+	second := types.NewIssuance(12, startTime.Add(65*time.Second), coins)
+
+	genesis := types.DefaultGenesisState()
+	genesis.Issuances = []types.Issuance{first, second}
+
+	mint.InitGenesis(suite.ctx, suite.app.MintKeeper, *genesis)
+	exportedGenesis := mint.ExportGenesis(suite.ctx, suite.app.MintKeeper)
+	suite.Equal(genesis, exportedGenesis)
+
+	genesis.Issuances = []types.Issuance{second, first}
+	suite.Error(mint.ValidateGenesis(*genesis))
+}
@@ -0,0 +1,43 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/mint/keeper"
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// NewMintBaseUpdateProposalHandler returns a handler for "mint" type governance proposals.
+func NewMintBaseUpdateProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.MintBaseUpdateProposal:
+			return keeper.HandleMintBaseUpdateProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized mint proposal content type: %T", c)
+		}
+	}
+}
+
+// NewParamChangeProposalHandler wraps the handler of the param change proposals, so that the
+// proposals setting a mint distribution to targets which aren't module accounts fail instead
+// of halting the chain in the next BeginBlocker
+func NewParamChangeProposalHandler(k keeper.Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := paramsHandler(cacheCtx, content); err != nil {
+			return err
+		}
+
+		if err := k.ValidateDistribution(k.GetParamSet(cacheCtx).Distribution); err != nil {
+			return err
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return nil
+	}
+}
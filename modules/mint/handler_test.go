@@ -0,0 +1,47 @@
+package mint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/irisnet/irishub/modules/mint"
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	app, ctx := createTestApp(false)
+	handler := mint.NewParamChangeProposalHandler(app.MintKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+
+	newProposal := func(distribution string) *paramproposal.ParameterChangeProposal {
+		return paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(types.ModuleName, string(types.KeyDistribution), distribution),
+		})
+	}
+
+	// the module accounts can be distribution targets
+	err := handler(ctx, newProposal(`[{"target":"fee_collector","weight":"0.5"},{"target":"community_pool","weight":"0.5"}]`))
+	require.NoError(t, err)
+	require.Equal(t, []types.DistributionTarget{
+		types.NewDistributionTarget("fee_collector", sdk.NewDecWithPrec(5, 1)),
+		types.NewDistributionTarget(types.CommunityPoolTarget, sdk.NewDecWithPrec(5, 1)),
+	}, app.MintKeeper.GetParamSet(ctx).Distribution)
+
+	// the proposals distributing to unknown or reserved accounts fail and leave the params untouched
+	for _, target := range []string{"unknown", "bonded_tokens_pool", types.ModuleName} {
+		err = handler(ctx, newProposal(`[{"target":"`+target+`","weight":"1"}]`))
+		require.Error(t, err, target)
+		require.Len(t, app.MintKeeper.GetParamSet(ctx).Distribution, 2)
+	}
+
+	// the other param changes are passed through
+	err = handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyInflation), `"0.1"`),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), app.MintKeeper.GetParamSet(ctx).Inflation)
+}
@@ -3,7 +3,12 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 )
@@ -17,3 +22,67 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// Minter queries the current minter
+func (k Keeper) Minter(c context.Context, _ *types.QueryMinterRequest) (*types.QueryMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+
+	return &types.QueryMinterResponse{Minter: minter}, nil
+}
+
+// Inflation queries the current inflation rate
+func (k Keeper) Inflation(c context.Context, _ *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	inflation := k.GetMinter(ctx).Inflation
+
+	return &types.QueryInflationResponse{Inflation: inflation}, nil
+}
+
+// AnnualProvisions queries the current annual provisions
+func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisionsRequest) (*types.QueryAnnualProvisionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	provisions := minter.NextAnnualProvisions(minter.Inflation)
+
+	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: provisions}, nil
+}
+
+// BlockProvision queries the provision minted in the current block
+func (k Keeper) BlockProvision(c context.Context, _ *types.QueryBlockProvisionRequest) (*types.QueryBlockProvisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	provision := k.GetBlockProvision(ctx)
+
+	return &types.QueryBlockProvisionResponse{BlockProvision: provision}, nil
+}
+
+// Issuances queries the issuance ledger within a height and time range
+func (k Keeper) Issuances(c context.Context, req *types.QueryIssuancesRequest) (*types.QueryIssuancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IssuanceKeyPrefix)
+
+	var issuances []types.Issuance
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var issuance types.Issuance
+		if err := k.cdc.UnmarshalBinaryBare(value, &issuance); err != nil {
+			return false, err
+		}
+
+		if !issuance.Overlaps(req.StartHeight, req.EndHeight, req.StartTime, req.EndTime) {
+			return false, nil
+		}
+		if accumulate {
+			issuances = append(issuances, issuance)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIssuancesResponse{Issuances: issuances, Pagination: pageRes}, nil
+}
@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 )
@@ -19,4 +20,26 @@ func (suite *KeeperTestSuite) TestGRPCQueryPoolParameters() {
 	resp, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.NoError(err)
 	suite.Equal(app.MintKeeper.GetParamSet(ctx), resp.Params)
+
+	// Query Minter
+	minterResp, err := queryClient.Minter(gocontext.Background(), &types.QueryMinterRequest{})
+	suite.NoError(err)
+	suite.Equal(app.MintKeeper.GetMinter(ctx), minterResp.Minter)
+
+	// Query Inflation
+	inflationResp, err := queryClient.Inflation(gocontext.Background(), &types.QueryInflationRequest{})
+	suite.NoError(err)
+	suite.Equal(app.MintKeeper.GetMinter(ctx).Inflation, inflationResp.Inflation)
+
+	// Query AnnualProvisions
+	annualResp, err := queryClient.AnnualProvisions(gocontext.Background(), &types.QueryAnnualProvisionsRequest{})
+	suite.NoError(err)
+	suite.Equal(minterResp.Minter.NextAnnualProvisions(minterResp.Minter.Inflation), annualResp.AnnualProvisions)
+
+	// Query BlockProvision
+	provision := sdk.NewCoin(types.MintDenom, sdk.NewInt(1000))
+	app.MintKeeper.SetBlockProvision(ctx, provision)
+	blockResp, err := queryClient.BlockProvision(gocontext.Background(), &types.QueryBlockProvisionRequest{})
+	suite.NoError(err)
+	suite.Equal(provision, blockResp.BlockProvision)
 }
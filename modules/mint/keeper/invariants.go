@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// RegisterInvariants registers all mint invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "max-supply", MaxSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "last-update", LastUpdateInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "inflation-base", InflationBaseInvariant(k))
+}
+
+// AllInvariants runs all invariants of the mint module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			MaxSupplyInvariant(k),
+			ModuleAccountInvariant(k),
+			LastUpdateInvariant(k),
+			InflationBaseInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// MaxSupplyInvariant checks that the total supply of the mint denom does not exceed its max supply
+func MaxSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		denom := k.GetParamSet(ctx).MintDenom
+		maxSupply, found := k.GetMaxSupply(ctx, denom)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, "max-supply", fmt.Sprintf("\tno max supply defined for %s\n", denom)), false
+		}
+
+		supply := k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)
+		broken := supply.GT(maxSupply)
+
+		return sdk.FormatInvariant(types.ModuleName, "max-supply", fmt.Sprintf(
+			"\ttotal supply of %s: %s\n\tmax supply: %s\n", denom, supply, maxSupply,
+		)), broken
+	}
+}
+
+// ModuleAccountInvariant checks that the mint module account holds no balance,
+// as all the minted coins are distributed in the same block
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		broken := !balance.IsZero()
+
+		return sdk.FormatInvariant(types.ModuleName, "module-account", fmt.Sprintf(
+			"\tmint module account balance: %s\n", balance,
+		)), broken
+	}
+}
+
+// LastUpdateInvariant checks that the last update time of the minter is not after the
+// current block time, otherwise the next update would move it backwards. Contexts
+// without a block time, such as the one used on export, are not checked
+func LastUpdateInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		lastUpdate := k.GetMinter(ctx).LastUpdate
+		blockTime := ctx.BlockTime()
+		broken := !blockTime.IsZero() && lastUpdate.After(blockTime)
+
+		return sdk.FormatInvariant(types.ModuleName, "last-update", fmt.Sprintf(
+			"\tminter last update: %s\n\tblock time: %s\n", lastUpdate, blockTime,
+		)), broken
+	}
+}
+
+// InflationBaseInvariant checks that the inflation base of the minter is positive, and
+// equals the one approved through genesis or governance
+func InflationBaseInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		inflationBase := k.GetMinter(ctx).InflationBase
+		broken := !inflationBase.IsPositive()
+
+		approved, found := k.GetInflationBase(ctx)
+		if found && !inflationBase.Equal(approved) {
+			broken = true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "inflation-base", fmt.Sprintf(
+			"\tminter inflation base: %s\n\tapproved inflation base: %s\n", inflationBase, approved,
+		)), broken
+	}
+}
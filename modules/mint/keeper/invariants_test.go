@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/irisnet/irishub/modules/mint/keeper"
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestMaxSupplyInvariant() {
+	denom := suite.app.MintKeeper.GetParamSet(suite.ctx).MintDenom
+	maxSupply, found := suite.app.MintKeeper.GetMaxSupply(suite.ctx, denom)
+	require.True(suite.T(), found)
+
+	invariant := keeper.MaxSupplyInvariant(suite.app.MintKeeper)
+
+	suite.app.BankKeeper.SetSupply(suite.ctx, banktypes.NewSupply(sdk.NewCoins(sdk.NewCoin(denom, maxSupply))))
+	_, broken := invariant(suite.ctx)
+	require.False(suite.T(), broken)
+
+	suite.app.BankKeeper.SetSupply(suite.ctx, banktypes.NewSupply(sdk.NewCoins(sdk.NewCoin(denom, maxSupply.AddRaw(1)))))
+	_, broken = invariant(suite.ctx)
+	require.True(suite.T(), broken)
+}
+
+func (suite *KeeperTestSuite) TestModuleAccountInvariant() {
+	invariant := keeper.ModuleAccountInvariant(suite.app.MintKeeper)
+
+	_, broken := invariant(suite.ctx)
+	require.False(suite.T(), broken)
+
+	err := suite.app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))))
+	require.NoError(suite.T(), err)
+	_, broken = invariant(suite.ctx)
+	require.True(suite.T(), broken)
+}
+
+func (suite *KeeperTestSuite) TestLastUpdateInvariant() {
+	invariant := keeper.LastUpdateInvariant(suite.app.MintKeeper)
+	blockTime := time.Unix(1600000000, 0).UTC()
+	ctx := suite.ctx.WithBlockTime(blockTime)
+
+	suite.app.MintKeeper.SetMinter(ctx, types.NewMinter(blockTime, sdk.NewInt(1000)))
+	_, broken := invariant(ctx)
+	require.False(suite.T(), broken)
+
+	// no block time to compare with
+	_, broken = invariant(ctx.WithBlockTime(time.Time{}))
+	require.False(suite.T(), broken)
+
+	suite.app.MintKeeper.SetMinter(ctx, types.NewMinter(blockTime.Add(time.Second), sdk.NewInt(1000)))
+	_, broken = invariant(ctx)
+	require.True(suite.T(), broken)
+}
+
+func (suite *KeeperTestSuite) TestInflationBaseInvariant() {
+	invariant := keeper.InflationBaseInvariant(suite.app.MintKeeper)
+
+	suite.app.MintKeeper.SetInflationBase(suite.ctx, sdk.NewInt(1000))
+	_, broken := invariant(suite.ctx)
+	require.False(suite.T(), broken)
+
+	// changed without approval
+	minter := suite.app.MintKeeper.GetMinter(suite.ctx)
+	minter.InflationBase = sdk.NewInt(2000)
+	suite.app.MintKeeper.SetMinter(suite.ctx, minter)
+	_, broken = invariant(suite.ctx)
+	require.True(suite.T(), broken)
+
+	suite.app.MintKeeper.SetInflationBase(suite.ctx, sdk.ZeroInt())
+	_, broken = invariant(suite.ctx)
+	require.True(suite.T(), broken)
+}
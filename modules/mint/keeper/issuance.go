@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// GetIssuance returns the issuance of the epoch starting at the given height
+func (k Keeper) GetIssuance(ctx sdk.Context, startHeight int64) (issuance types.Issuance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetIssuanceKey(startHeight))
+	if b == nil {
+		return issuance, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(b, &issuance)
+	return issuance, true
+}
+
+// SetIssuance sets the issuance of an epoch
+func (k Keeper) SetIssuance(ctx sdk.Context, issuance types.Issuance) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryBare(&issuance)
+	store.Set(types.GetIssuanceKey(issuance.StartHeight), b)
+}
+
+// GetLastIssuance returns the issuance of the current epoch
+func (k Keeper) GetLastIssuance(ctx sdk.Context) (issuance types.Issuance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.IssuanceKeyPrefix)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return issuance, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &issuance)
+	return issuance, true
+}
+
+// IterateIssuances iterates through the issuance ledger by ascending height
+func (k Keeper) IterateIssuances(ctx sdk.Context, op func(issuance types.Issuance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.IssuanceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var issuance types.Issuance
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &issuance)
+
+		if op(issuance) {
+			break
+		}
+	}
+}
+
+// GetIssuances returns the whole issuance ledger
+func (k Keeper) GetIssuances(ctx sdk.Context) (issuances []types.Issuance) {
+	k.IterateIssuances(ctx, func(issuance types.Issuance) bool {
+		issuances = append(issuances, issuance)
+		return false
+	})
+	return
+}
+
+// RecordIssuance adds the coins minted in the current block to the issuance of the current
+// epoch. A new epoch is started once the current one spans IssuanceEpoch blocks, and the
+// oldest epochs beyond IssuanceRetention are pruned
+func (k Keeper) RecordIssuance(ctx sdk.Context, params types.Params, minted sdk.Coins) {
+	height := ctx.BlockHeight()
+
+	issuance, found := k.GetLastIssuance(ctx)
+	if found && uint64(height-issuance.StartHeight) < params.IssuanceEpoch {
+		issuance.EndHeight = height
+		issuance.EndTime = ctx.BlockTime()
+		issuance.Amount = issuance.Amount.Add(minted...)
+		k.SetIssuance(ctx, issuance)
+		return
+	}
+
+	k.SetIssuance(ctx, types.NewIssuance(height, ctx.BlockTime(), minted))
+	k.pruneIssuances(ctx, params.IssuanceRetention)
+}
+
+// pruneIssuances deletes the oldest epochs beyond the given retention, 0 keeps all of them
+func (k Keeper) pruneIssuances(ctx sdk.Context, retention uint64) {
+	if retention == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.IssuanceKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for kept := uint64(0); iterator.Valid(); iterator.Next() {
+		if kept < retention {
+			kept++
+			continue
+		}
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// recordIssuances records a minted coin for each block from the given height
+func (suite *KeeperTestSuite) recordIssuances(params types.Params, fromHeight, blocks int64, blockTime time.Time) {
+	minted := sdk.NewCoins(sdk.NewCoin(types.MintDenom, sdk.NewInt(10)))
+	for height := fromHeight; height < fromHeight+blocks; height++ {
+		ctx := suite.ctx.WithBlockHeight(height).WithBlockTime(blockTime.Add(time.Duration(height) * 5 * time.Second))
+		suite.app.MintKeeper.RecordIssuance(ctx, params, minted)
+	}
+}
+
+func (suite *KeeperTestSuite) TestRecordIssuance() {
+	params := types.DefaultParams()
+	params.IssuanceEpoch = 10
+	blockTime := time.Unix(1600000000, 0).UTC()
+
+	suite.recordIssuances(params, 2, 25, blockTime)
+
+	issuances := suite.app.MintKeeper.GetIssuances(suite.ctx)
+	require.Len(suite.T(), issuances, 3)
+	expected := []struct {
+		startHeight, endHeight int64
+		amount                 int64
+	}{
+		{2, 11, 100},
+		{12, 21, 100},
+		{22, 26, 50},
+	}
+	for i, e := range expected {
+		require.Equal(suite.T(), e.startHeight, issuances[i].StartHeight)
+		require.Equal(suite.T(), e.endHeight, issuances[i].EndHeight)
+		require.Equal(suite.T(), blockTime.Add(time.Duration(e.startHeight)*5*time.Second), issuances[i].StartTime)
+		require.Equal(suite.T(), blockTime.Add(time.Duration(e.endHeight)*5*time.Second), issuances[i].EndTime)
+		require.Equal(suite.T(), sdk.NewInt(e.amount), issuances[i].Amount.AmountOf(types.MintDenom))
+	}
+
+	last, found := suite.app.MintKeeper.GetLastIssuance(suite.ctx)
+	require.True(suite.T(), found)
+	require.Equal(suite.T(), issuances[2], last)
+}
+
+func (suite *KeeperTestSuite) TestPruneIssuances() {
+	params := types.DefaultParams()
+	params.IssuanceEpoch = 10
+	params.IssuanceRetention = 2
+
+	suite.recordIssuances(params, 2, 45, time.Unix(1600000000, 0).UTC())
+
+	issuances := suite.app.MintKeeper.GetIssuances(suite.ctx)
+	require.Len(suite.T(), issuances, 2)
+	require.Equal(suite.T(), int64(32), issuances[0].StartHeight)
+	require.Equal(suite.T(), int64(42), issuances[1].StartHeight)
+
+	_, found := suite.app.MintKeeper.GetIssuance(suite.ctx, 22)
+	require.False(suite.T(), found)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryIssuances() {
+	app, ctx := suite.app, suite.ctx
+	params := types.DefaultParams()
+	params.IssuanceEpoch = 10
+	blockTime := time.Unix(1600000000, 0).UTC()
+
+	suite.recordIssuances(params, 2, 50, blockTime)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.MintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	resp, err := queryClient.Issuances(gocontext.Background(), &types.QueryIssuancesRequest{})
+	suite.NoError(err)
+	suite.Len(resp.Issuances, 5)
+
+	// by height range
+	resp, err = queryClient.Issuances(gocontext.Background(), &types.QueryIssuancesRequest{StartHeight: 15, EndHeight: 22})
+	suite.NoError(err)
+	suite.Len(resp.Issuances, 2)
+	suite.Equal(int64(12), resp.Issuances[0].StartHeight)
+	suite.Equal(int64(22), resp.Issuances[1].StartHeight)
+
+	// by time range
+	startTime := blockTime.Add(30 * 5 * time.Second)
+	resp, err = queryClient.Issuances(gocontext.Background(), &types.QueryIssuancesRequest{StartTime: &startTime})
+	suite.NoError(err)
+	suite.Len(resp.Issuances, 3)
+	suite.Equal(int64(22), resp.Issuances[0].StartHeight)
+
+	// paginated
+	resp, err = queryClient.Issuances(gocontext.Background(), &types.QueryIssuancesRequest{
+		StartHeight: 12,
+		Pagination:  &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(resp.Issuances, 2)
+	suite.Equal(int64(12), resp.Issuances[0].StartHeight)
+	suite.Equal(uint64(4), resp.Pagination.Total)
+
+	resp, err = queryClient.Issuances(gocontext.Background(), &types.QueryIssuancesRequest{
+		StartHeight: 12,
+		Pagination:  &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+	})
+	suite.NoError(err)
+	suite.Len(resp.Issuances, 2)
+	suite.Equal(int64(32), resp.Issuances[0].StartHeight)
+}
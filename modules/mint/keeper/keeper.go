@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/irisnet/irishub/modules/mint/types"
@@ -17,14 +18,18 @@ type Keeper struct {
 	cdc              codec.Marshaler
 	storeKey         sdk.StoreKey
 	paramSpace       paramtypes.Subspace
+	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	stakingKeeper    types.StakingKeeper
+	distrKeeper      types.DistrKeeper
+	tokenKeeper      types.TokenKeeper
 	feeCollectorName string
 }
 
 // NewKeeper returns a mint keeper
 func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey,
 	paramSpace paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string) Keeper {
+	sk types.StakingKeeper, dk types.DistrKeeper, tk types.TokenKeeper, feeCollectorName string) Keeper {
 
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -35,7 +40,11 @@ func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey,
 		storeKey:         key,
 		cdc:              cdc,
 		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		accountKeeper:    ak,
 		bankKeeper:       bk,
+		stakingKeeper:    sk,
+		distrKeeper:      dk,
+		tokenKeeper:      tk,
 		feeCollectorName: feeCollectorName,
 	}
 	return keeper
@@ -66,6 +75,57 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store.Set(types.MinterKey, b)
 }
 
+// GetInflationBase returns the inflation base approved through genesis or governance,
+// and false if none has been recorded
+func (k Keeper) GetInflationBase(ctx sdk.Context) (sdk.Int, bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.InflationBaseKey)
+	if b == nil {
+		return sdk.ZeroInt(), false
+	}
+	var base sdk.IntProto
+	k.cdc.MustUnmarshalBinaryBare(b, &base)
+	return base.Int, true
+}
+
+// SetInflationBase sets the inflation base of the minter and records it as approved.
+// It is the only path through which the inflation base may change
+func (k Keeper) SetInflationBase(ctx sdk.Context, base sdk.Int) {
+	minter := k.GetMinter(ctx)
+	minter.InflationBase = base
+	k.SetMinter(ctx, minter)
+
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryBare(&sdk.IntProto{Int: base})
+	store.Set(types.InflationBaseKey, b)
+}
+
+// GetBlockProvision returns the provision minted in the current block
+func (k Keeper) GetBlockProvision(ctx sdk.Context) (provision sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BlockProvisionKey)
+	if b == nil {
+		return sdk.NewCoin(k.GetParamSet(ctx).MintDenom, sdk.ZeroInt())
+	}
+	k.cdc.MustUnmarshalBinaryBare(b, &provision)
+	return
+}
+
+// SetBlockProvision sets the provision minted in the current block
+func (k Keeper) SetBlockProvision(ctx sdk.Context, provision sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryBare(&provision)
+	store.Set(types.BlockProvisionKey, b)
+}
+
+// NextInflation returns the inflation rate of the current block according to the inflation mode
+func (k Keeper) NextInflation(ctx sdk.Context, minter types.Minter, params types.Params) sdk.Dec {
+	if params.Mode == types.BondedRatio {
+		return minter.NextInflationRate(params, k.stakingKeeper.BondedRatio(ctx), ctx.BlockTime())
+	}
+	return params.InflationAt(ctx.BlockHeight(), ctx.BlockTime())
+}
+
 // MintCoins implements an alias call to the underlying supply keeper's
 // MintCoins to be used in BeginBlocker.
 func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
@@ -76,12 +136,89 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, newCoins)
 }
 
+// GetMaxSupply returns the max supply of the given denom, in its minimum unit, as
+// defined by the token module. It returns false if the token is not defined
+func (k Keeper) GetMaxSupply(ctx sdk.Context, denom string) (sdk.Int, bool) {
+	token, err := k.tokenKeeper.GetToken(ctx, denom)
+	if err != nil {
+		return sdk.ZeroInt(), false
+	}
+
+	maxSupply := sdk.NewIntFromUint64(token.GetMaxSupply())
+	if token.GetMinUnit() == denom {
+		maxSupply = maxSupply.Mul(sdk.NewIntWithDecimal(1, int(token.GetScale())))
+	}
+	return maxSupply, true
+}
+
+// ClampToMaxSupply clamps the coin to mint so that the total supply does not exceed
+// the max supply. It returns true if minting the clamped coin reaches the max supply
+func (k Keeper) ClampToMaxSupply(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, bool) {
+	maxSupply, found := k.GetMaxSupply(ctx, coin.Denom)
+	if !found {
+		return coin, false
+	}
+
+	remaining := maxSupply.Sub(k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(coin.Denom))
+	if !remaining.IsPositive() {
+		return sdk.NewCoin(coin.Denom, sdk.ZeroInt()), false
+	}
+	if coin.Amount.GTE(remaining) {
+		return sdk.NewCoin(coin.Denom, remaining), true
+	}
+	return coin, false
+}
+
 // AddCollectedFees implements an alias call to the underlying supply keeper's
 // AddCollectedFees to be used in BeginBlocker.
 func (k Keeper) AddCollectedFees(ctx sdk.Context, coins sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, coins)
 }
 
+// ValidateDistribution returns err if the distribution is invalid or a target of the distribution
+// is not a module account with permissions registered in the account keeper
+func (k Keeper) ValidateDistribution(distribution []types.DistributionTarget) error {
+	if err := types.ValidateDistribution(distribution); err != nil {
+		return err
+	}
+	for _, t := range distribution {
+		if t.Target == types.CommunityPoolTarget {
+			continue
+		}
+		if addr := k.accountKeeper.GetModuleAddress(t.Target); addr == nil {
+			return sdkerrors.Wrapf(types.ErrInvalidDistribution, "module account [%s] does not exist", t.Target)
+		}
+	}
+	return nil
+}
+
+// DistributeMintedCoins splits the minted coins across the distribution targets, and returns
+// the targets with their shares. It returns err if the distribution is invalid, which can't be
+// set by genesis or governance
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, distribution []types.DistributionTarget, coins sdk.Coins) ([]types.DistributionTarget, []sdk.Coins, error) {
+	if err := k.ValidateDistribution(distribution); err != nil {
+		return nil, nil, err
+	}
+
+	shares := types.Split(distribution, coins)
+	for i, t := range distribution {
+		if shares[i].Empty() {
+			continue
+		}
+
+		var err error
+		if t.Target == types.CommunityPoolTarget {
+			err = k.distrKeeper.FundCommunityPool(ctx, shares[i], k.accountKeeper.GetModuleAddress(types.ModuleName))
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, t.Target, shares[i])
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return distribution, shares, nil
+}
+
 // GetParamSet returns inflation params from the global param store
 func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
 	var params types.Params
@@ -12,6 +12,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 	"github.com/irisnet/irishub/simapp"
@@ -91,3 +95,88 @@ func (suite *KeeperTestSuite) TestAddCollectedFees() {
 	require.Equal(suite.T(), coins1, mintCoins)
 
 }
+
+func (suite *KeeperTestSuite) TestDistributeMintedCoins() {
+	suite.app.BankKeeper.SetSupply(suite.ctx, &banktypes.Supply{})
+	suite.app.DistrKeeper.SetFeePool(suite.ctx, distributiontypes.InitialFeePool())
+
+	mintCoins := sdk.NewCoins(sdk.NewCoin("iris", sdk.NewInt(1000)))
+	err := suite.app.MintKeeper.MintCoins(suite.ctx, mintCoins)
+	require.NoError(suite.T(), err)
+
+	distribution := []types.DistributionTarget{
+		types.NewDistributionTarget("fee_collector", sdk.NewDecWithPrec(5, 1)),
+		types.NewDistributionTarget(types.CommunityPoolTarget, sdk.NewDecWithPrec(3, 1)),
+		types.NewDistributionTarget(htlctypes.ModuleName, sdk.NewDecWithPrec(2, 1)),
+	}
+	require.NoError(suite.T(), suite.app.MintKeeper.ValidateDistribution(distribution))
+
+	targets, shares, err := suite.app.MintKeeper.DistributeMintedCoins(suite.ctx, distribution, mintCoins)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), distribution, targets)
+	require.Len(suite.T(), shares, 3)
+
+	acc := suite.app.AccountKeeper.GetModuleAccount(suite.ctx, types.ModuleName)
+	require.True(suite.T(), suite.app.BankKeeper.GetAllBalances(suite.ctx, acc.GetAddress()).Empty())
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAccount(suite.ctx, "fee_collector")
+	require.Equal(suite.T(), shares[0], suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector.GetAddress()))
+
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	require.Equal(suite.T(), sdk.NewDecCoinsFromCoins(shares[1]...), communityPool)
+
+	htlc := suite.app.AccountKeeper.GetModuleAccount(suite.ctx, htlctypes.ModuleName)
+	require.Equal(suite.T(), shares[2], suite.app.BankKeeper.GetAllBalances(suite.ctx, htlc.GetAddress()))
+}
+
+func (suite *KeeperTestSuite) TestDistributeMintedCoinsUnknownTarget() {
+	suite.app.BankKeeper.SetSupply(suite.ctx, &banktypes.Supply{})
+
+	mintCoins := sdk.NewCoins(sdk.NewCoin("iris", sdk.NewInt(1000)))
+	err := suite.app.MintKeeper.MintCoins(suite.ctx, mintCoins)
+	require.NoError(suite.T(), err)
+
+	distribution := []types.DistributionTarget{
+		types.NewDistributionTarget("fee_collector", sdk.NewDecWithPrec(5, 1)),
+		types.NewDistributionTarget("unknown", sdk.NewDecWithPrec(5, 1)),
+	}
+	require.Error(suite.T(), suite.app.MintKeeper.ValidateDistribution(distribution))
+
+	// no coins are redirected to the fee collector
+	_, _, err = suite.app.MintKeeper.DistributeMintedCoins(suite.ctx, distribution, mintCoins)
+	require.Error(suite.T(), err)
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAccount(suite.ctx, "fee_collector")
+	require.True(suite.T(), suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector.GetAddress()).Empty())
+}
+
+func (suite *KeeperTestSuite) TestClampToMaxSupply() {
+	denom := sdk.DefaultBondDenom
+
+	// no max supply without a token definition
+	_, found := suite.app.MintKeeper.GetMaxSupply(suite.ctx, "unknown")
+	require.False(suite.T(), found)
+
+	maxSupply, found := suite.app.MintKeeper.GetMaxSupply(suite.ctx, denom)
+	require.True(suite.T(), found)
+	require.Equal(suite.T(), sdk.NewIntFromUint64(tokentypes.GetNativeToken().MaxSupply), maxSupply)
+
+	tests := []struct {
+		supply   sdk.Int
+		mint     sdk.Int
+		expected sdk.Int
+		reached  bool
+	}{
+		{maxSupply.SubRaw(100), sdk.NewInt(10), sdk.NewInt(10), false},
+		{maxSupply.SubRaw(10), sdk.NewInt(10), sdk.NewInt(10), true},
+		{maxSupply.SubRaw(10), sdk.NewInt(100), sdk.NewInt(10), true},
+		{maxSupply, sdk.NewInt(100), sdk.ZeroInt(), false},
+		{maxSupply.AddRaw(10), sdk.NewInt(100), sdk.ZeroInt(), false},
+	}
+	for i, tc := range tests {
+		suite.app.BankKeeper.SetSupply(suite.ctx, banktypes.NewSupply(sdk.NewCoins(sdk.NewCoin(denom, tc.supply))))
+		coin, reached := suite.app.MintKeeper.ClampToMaxSupply(suite.ctx, sdk.NewCoin(denom, tc.mint))
+		require.Equal(suite.T(), sdk.NewCoin(denom, tc.expected), coin, "%d", i)
+		require.Equal(suite.T(), tc.reached, reached, "%d", i)
+	}
+}
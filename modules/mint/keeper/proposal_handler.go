@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// HandleMintBaseUpdateProposal is a handler for executing a passed mint base update proposal
+func HandleMintBaseUpdateProposal(ctx sdk.Context, k Keeper, p *types.MintBaseUpdateProposal) error {
+	inflationBase := p.InflationBase
+	if p.FromSupply {
+		denom := k.GetParamSet(ctx).MintDenom
+		inflationBase = k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)
+	}
+	if !inflationBase.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidInflationBase, "inflation base (%s) must be positive", inflationBase)
+	}
+
+	k.SetInflationBase(ctx, inflationBase)
+
+	logger := k.Logger(ctx)
+	logger.Info("Inflation base updated", "inflation_base", inflationBase.String())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateMintBase,
+			sdk.NewAttribute(types.AttributeKeyInflationBase, inflationBase.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/irisnet/irishub/modules/mint/keeper"
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestHandleMintBaseUpdateProposal() {
+	denom := suite.app.MintKeeper.GetParamSet(suite.ctx).MintDenom
+
+	// explicit base
+	err := keeper.HandleMintBaseUpdateProposal(suite.ctx, suite.app.MintKeeper, types.NewMintBaseUpdateProposal("title", "description", sdk.NewInt(1000)))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), sdk.NewInt(1000), suite.app.MintKeeper.GetMinter(suite.ctx).InflationBase)
+
+	// derived from the supply
+	supply := sdk.NewInt(5000)
+	suite.app.BankKeeper.SetSupply(suite.ctx, banktypes.NewSupply(sdk.NewCoins(sdk.NewCoin(denom, supply))))
+	err = keeper.HandleMintBaseUpdateProposal(suite.ctx, suite.app.MintKeeper, types.NewMintBaseUpdateFromSupplyProposal("title", "description"))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), supply, suite.app.MintKeeper.GetMinter(suite.ctx).InflationBase)
+
+	approved, found := suite.app.MintKeeper.GetInflationBase(suite.ctx)
+	require.True(suite.T(), found)
+	require.Equal(suite.T(), supply, approved)
+
+	_, broken := keeper.InflationBaseInvariant(suite.app.MintKeeper)(suite.ctx)
+	require.False(suite.T(), broken)
+
+	// no supply of the mint denom
+	suite.app.BankKeeper.SetSupply(suite.ctx, banktypes.NewSupply(sdk.NewCoins()))
+	err = keeper.HandleMintBaseUpdateProposal(suite.ctx, suite.app.MintKeeper, types.NewMintBaseUpdateFromSupplyProposal("title", "description"))
+	require.Error(suite.T(), err)
+	require.Equal(suite.T(), supply, suite.app.MintKeeper.GetMinter(suite.ctx).InflationBase)
+}
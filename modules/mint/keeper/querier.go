@@ -3,6 +3,7 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,10 +13,20 @@ import (
 
 // NewQuerier returns a minting Querier handler.
 func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
-	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		switch path[0] {
 		case types.QueryParameters:
 			return queryParams(ctx, k, legacyQuerierCdc)
+		case types.QueryMinter:
+			return queryMinter(ctx, k, legacyQuerierCdc)
+		case types.QueryInflation:
+			return queryInflation(ctx, k, legacyQuerierCdc)
+		case types.QueryAnnualProvisions:
+			return queryAnnualProvisions(ctx, k, legacyQuerierCdc)
+		case types.QueryBlockProvision:
+			return queryBlockProvision(ctx, k, legacyQuerierCdc)
+		case types.QueryIssuances:
+			return queryIssuances(ctx, req, k, legacyQuerierCdc)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -32,3 +43,77 @@ func queryParams(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino)
 
 	return res, nil
 }
+
+func queryMinter(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	minter := k.GetMinter(ctx)
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, minter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryInflation(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	inflation := k.GetMinter(ctx).Inflation
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, inflation)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAnnualProvisions(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	minter := k.GetMinter(ctx)
+	provisions := minter.NextAnnualProvisions(minter.Inflation)
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, provisions)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryBlockProvision(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	provision := k.GetBlockProvision(ctx)
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, provision)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryIssuances(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryIssuancesParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	issuances := []types.Issuance{}
+	k.IterateIssuances(ctx, func(issuance types.Issuance) bool {
+		if issuance.Overlaps(params.StartHeight, params.EndHeight, params.StartTime, params.EndTime) {
+			issuances = append(issuances, issuance)
+		}
+		return false
+	})
+
+	start, end := client.Paginate(len(issuances), params.Page, params.Limit, len(issuances))
+	if start < 0 || end < 0 {
+		issuances = []types.Issuance{}
+	} else {
+		issuances = issuances[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, issuances)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -2,11 +2,14 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/irisnet/irishub/modules/mint/keeper"
 	"github.com/irisnet/irishub/modules/mint/types"
 )
@@ -29,4 +32,56 @@ func (suite *KeeperTestSuite) TestNewQuerier() {
 	e := suite.cdc.UnmarshalJSON(res, &params)
 	suite.NoError(e)
 	suite.Equal(suite.app.MintKeeper.GetParamSet(suite.ctx), params)
+
+	// test queryMinter
+
+	res, err = querier(suite.ctx, []string{types.QueryMinter}, abci.RequestQuery{})
+	suite.NoError(err)
+	var minter types.Minter
+	e = suite.cdc.UnmarshalJSON(res, &minter)
+	suite.NoError(e)
+	suite.Equal(suite.app.MintKeeper.GetMinter(suite.ctx), minter)
+
+	// test queryInflation
+
+	res, err = querier(suite.ctx, []string{types.QueryInflation}, abci.RequestQuery{})
+	suite.NoError(err)
+	var inflation sdk.Dec
+	e = suite.cdc.UnmarshalJSON(res, &inflation)
+	suite.NoError(e)
+	suite.Equal(minter.Inflation, inflation)
+
+	// test queryAnnualProvisions
+
+	res, err = querier(suite.ctx, []string{types.QueryAnnualProvisions}, abci.RequestQuery{})
+	suite.NoError(err)
+	var annualProvisions sdk.Dec
+	e = suite.cdc.UnmarshalJSON(res, &annualProvisions)
+	suite.NoError(e)
+	suite.Equal(minter.NextAnnualProvisions(minter.Inflation), annualProvisions)
+
+	// test queryBlockProvision
+
+	res, err = querier(suite.ctx, []string{types.QueryBlockProvision}, abci.RequestQuery{})
+	suite.NoError(err)
+	var blockProvision sdk.Coin
+	e = suite.cdc.UnmarshalJSON(res, &blockProvision)
+	suite.NoError(e)
+	suite.Equal(suite.app.MintKeeper.GetBlockProvision(suite.ctx), blockProvision)
+
+	// test queryIssuances
+
+	params = types.DefaultParams()
+	params.IssuanceEpoch = 10
+	suite.recordIssuances(params, 2, 30, time.Unix(1600000000, 0).UTC())
+
+	bz, e := suite.cdc.MarshalJSON(types.QueryIssuancesParams{StartHeight: 15, Page: 1, Limit: 1})
+	suite.NoError(e)
+	res, err = querier(suite.ctx, []string{types.QueryIssuances}, abci.RequestQuery{Data: bz})
+	suite.NoError(err)
+	var issuances []types.Issuance
+	e = suite.cdc.UnmarshalJSON(res, &issuances)
+	suite.NoError(e)
+	suite.Len(issuances, 1)
+	suite.Equal(int64(12), issuances[0].StartHeight)
 }
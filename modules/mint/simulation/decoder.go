@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/irisnet/irishub/modules/mint/types"
@@ -20,6 +21,21 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &minterA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &minterB)
 			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.Equal(kvA.Key, types.BlockProvisionKey):
+			var provisionA, provisionB sdk.Coin
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &provisionA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &provisionB)
+			return fmt.Sprintf("%v\n%v", provisionA, provisionB)
+		case bytes.Equal(kvA.Key, types.InflationBaseKey):
+			var baseA, baseB sdk.IntProto
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &baseA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &baseB)
+			return fmt.Sprintf("%v\n%v", baseA.Int, baseB.Int)
+		case bytes.Equal(kvA.Key[:1], types.IssuanceKeyPrefix):
+			var issuanceA, issuanceB types.Issuance
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &issuanceA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &issuanceB)
+			return fmt.Sprintf("%v\n%v", issuanceA, issuanceB)
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
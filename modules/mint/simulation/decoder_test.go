@@ -17,12 +17,18 @@ import (
 
 func TestDecodeStore(t *testing.T) {
 	minter := types.NewMinter(time.Now().UTC(), sdk.NewIntWithDecimal(2, 9))
+	provision := sdk.NewCoin(types.MintDenom, sdk.NewInt(1000))
+	issuance := types.NewIssuance(10, time.Now().UTC(), sdk.NewCoins(provision))
+	base := sdk.IntProto{Int: minter.InflationBase}
 	cdc, _ := simapp.MakeCodecs()
 	dec := simulation.NewDecodeStore(cdc)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.MinterKey, Value: cdc.MustMarshalBinaryBare(&minter)},
+			{Key: types.BlockProvisionKey, Value: cdc.MustMarshalBinaryBare(&provision)},
+			{Key: types.InflationBaseKey, Value: cdc.MustMarshalBinaryBare(&base)},
+			{Key: types.GetIssuanceKey(issuance.StartHeight), Value: cdc.MustMarshalBinaryBare(&issuance)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -31,6 +37,9 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"BlockProvision", fmt.Sprintf("%v\n%v", provision, provision)},
+		{"InflationBase", fmt.Sprintf("%v\n%v", base.Int, base.Int)},
+		{"Issuance", fmt.Sprintf("%v\n%v", issuance, issuance)},
 		{"other", ""},
 	}
 
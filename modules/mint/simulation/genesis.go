@@ -6,9 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 )
@@ -16,11 +19,56 @@ import (
 // Simulation parameter constants
 const (
 	Inflation = "inflation"
+	MintDenom = "mint_denom"
+	Schedule  = "schedule"
+	Mode      = "mode"
 )
 
 // GenInflation randomized Inflation
 func GenInflation(r *rand.Rand) sdk.Dec {
-	return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
+	return sdk.NewDecWithPrec(int64(r.Intn(21)), 2)
+}
+
+// GenMintDenom randomized MintDenom, which keeps the default denom most of the time
+func GenMintDenom(r *rand.Rand) string {
+	if r.Intn(4) != 0 {
+		return types.MintDenom
+	}
+	return "mint" + strings.ToLower(simtypes.RandStringOfLength(r, 4))
+}
+
+// GenMode randomized inflation Mode
+func GenMode(r *rand.Rand) types.InflationMode {
+	return types.InflationMode(r.Intn(len(types.InflationMode_name)))
+}
+
+// GenSchedule randomized inflation Schedule, of either steps starting at a height or steps starting
+// at a time with a random decay. The latter are only generated when genesisTime is not zero
+func GenSchedule(r *rand.Rand, genesisTime time.Time) []types.InflationStep {
+	var schedule []types.InflationStep
+
+	var startHeight int64
+	var startTime time.Time
+	heightSteps := genesisTime.IsZero() || r.Intn(2) == 0
+	steps := r.Intn(4)
+	for i := 0; i < steps; i++ {
+		rate := sdk.NewDecWithPrec(int64(r.Intn(21)), 2)
+
+		if heightSteps {
+			startHeight += int64(1 + r.Intn(100))
+			schedule = append(schedule, types.NewInflationStep(nil, startHeight, rate, sdk.ZeroDec()))
+			continue
+		}
+
+		if startTime.IsZero() {
+			startTime = genesisTime
+		}
+		startTime = startTime.Add(time.Duration(1+r.Intn(600)) * time.Second)
+		stepTime := startTime
+		decay := sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+		schedule = append(schedule, types.NewInflationStep(&stepTime, 0, rate, decay))
+	}
+	return schedule
 }
 
 // RandomizedGenState generates a random GenesisState for mint
@@ -32,8 +80,21 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { inflation = GenInflation(r) },
 	)
 
-	params := types.Params{Inflation: inflation, MintDenom: types.MintDenom}
-	mintGenesis := types.NewGenesisState(types.DefaultMinter(), params)
+	var schedule []types.InflationStep
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Schedule, &schedule, simState.Rand,
+		func(r *rand.Rand) { schedule = GenSchedule(r, simState.GenTimestamp) },
+	)
+
+	var mode types.InflationMode
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Mode, &mode, simState.Rand,
+		func(r *rand.Rand) { mode = GenMode(r) },
+	)
+
+	params := types.NewParams(types.MintDenom, inflation, schedule)
+	params.Mode = mode
+	mintGenesis := types.NewGenesisState(types.DefaultMinter(), params, nil)
 
 	bz, err := json.MarshalIndent(&mintGenesis, "", " ")
 	if err != nil {
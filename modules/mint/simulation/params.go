@@ -5,7 +5,9 @@ package simulation
 import (
 	"fmt"
 	"math/rand"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
@@ -23,5 +25,19 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenInflation(r))
 			},
 		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyMintDenom),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMintDenom(r))
+			},
+		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeySchedule),
+			func(r *rand.Rand) string {
+				return string(codec.NewLegacyAmino().MustMarshalJSON(GenSchedule(r, time.Time{})))
+			},
+		),
 	}
 }
@@ -0,0 +1,66 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/mint/simulation"
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestParamChanges(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	cdc := codec.NewLegacyAmino()
+	params := types.DefaultParams()
+
+	paramChanges := simulation.ParamChanges(r)
+	require.Len(t, paramChanges, 3)
+
+	for i := 0; i < 20; i++ {
+		for _, pc := range paramChanges {
+			require.Equal(t, types.ModuleName, pc.Subspace())
+
+			value := pc.SimValue()(r)
+			switch pc.Key() {
+			case string(types.KeyInflation):
+				var inflation sdk.Dec
+				require.NoError(t, json.Unmarshal([]byte(value), &inflation))
+				params.Inflation = inflation
+			case string(types.KeyMintDenom):
+				var denom string
+				require.NoError(t, json.Unmarshal([]byte(value), &denom))
+				params.MintDenom = denom
+			case string(types.KeySchedule):
+				var schedule []types.InflationStep
+				require.NoError(t, cdc.UnmarshalJSON([]byte(value), &schedule))
+				params.Schedule = schedule
+			default:
+				t.Fatalf("unexpected param change %s", pc.Key())
+			}
+			require.NoError(t, params.Validate(), value)
+		}
+	}
+}
+
+func TestProposalContents(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	weightedProposalContents := simulation.ProposalContents()
+	require.Len(t, weightedProposalContents, 1)
+
+	w := weightedProposalContents[0]
+	require.Equal(t, simulation.OpWeightSubmitMintBaseUpdateProposal, w.AppParamsKey())
+	require.Equal(t, simulation.DefaultWeightMintBaseUpdateProposal, w.DefaultWeight())
+
+	for i := 0; i < 20; i++ {
+		content := w.ContentSimulatorFn()(r, sdk.Context{}, nil)
+		require.NoError(t, content.ValidateBasic())
+		require.Equal(t, types.ProposalTypeMintBaseUpdate, content.ProposalType())
+	}
+}
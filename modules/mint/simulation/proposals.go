@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+const (
+	// OpWeightSubmitMintBaseUpdateProposal app params key for mint base update proposal
+	OpWeightSubmitMintBaseUpdateProposal = "op_weight_submit_mint_base_update_proposal"
+
+	// DefaultWeightMintBaseUpdateProposal default weight of the mint base update proposal
+	DefaultWeightMintBaseUpdateProposal = 5
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents() []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitMintBaseUpdateProposal,
+			DefaultWeightMintBaseUpdateProposal,
+			SimulateMintBaseUpdateProposalContent,
+		),
+	}
+}
+
+// SimulateMintBaseUpdateProposalContent generates random mint base update proposal content
+func SimulateMintBaseUpdateProposalContent(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) simtypes.Content {
+	title := simtypes.RandStringOfLength(r, 10)
+	description := simtypes.RandStringOfLength(r, 100)
+
+	if r.Intn(2) == 0 {
+		return types.NewMintBaseUpdateFromSupplyProposal(title, description)
+	}
+
+	inflationBase := sdk.NewIntWithDecimal(int64(simtypes.RandIntBetween(r, 1, 10)), 15)
+	return types.NewMintBaseUpdateProposal(title, description, inflationBase)
+}
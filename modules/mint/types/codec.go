@@ -2,9 +2,23 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// RegisterLegacyAminoCodec registers the necessary module/mint interfaces and concrete types
+// on the provided Amino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MintBaseUpdateProposal{}, "irishub/mint/MintBaseUpdateProposal", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&MintBaseUpdateProposal{},
+	)
+}
+
 var (
 	amino = codec.NewLegacyAmino()
 
@@ -12,6 +26,7 @@ var (
 )
 
 func init() {
+	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
 }
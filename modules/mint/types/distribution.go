@@ -0,0 +1,95 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// CommunityPoolTarget is the distribution target funding the community pool
+const CommunityPoolTarget = "community_pool"
+
+// reservedTargets are the module accounts which can't receive the minted coins, since their
+// balances are tracked by the invariants of their modules. The community pool is funded by
+// the CommunityPoolTarget rather than the distribution module account
+var reservedTargets = map[string]bool{
+	ModuleName:                     true,
+	stakingtypes.BondedPoolName:    true,
+	stakingtypes.NotBondedPoolName: true,
+	distrtypes.ModuleName:          true,
+	govtypes.ModuleName:            true,
+}
+
+// NewDistributionTarget creates a new DistributionTarget instance
+func NewDistributionTarget(target string, weight sdk.Dec) DistributionTarget {
+	return DistributionTarget{
+		Target: target,
+		Weight: weight,
+	}
+}
+
+// DefaultDistribution sends all the minted coins to the fee collector
+func DefaultDistribution() []DistributionTarget {
+	return []DistributionTarget{
+		NewDistributionTarget(authtypes.FeeCollectorName, sdk.OneDec()),
+	}
+}
+
+// ValidateDistribution returns err if the distribution is invalid, that is the targets
+// are not unique or reserved, or the weights do not sum to 1
+func ValidateDistribution(distribution []DistributionTarget) error {
+	if len(distribution) == 0 {
+		return sdkerrors.Wrap(ErrInvalidDistribution, "distribution should not be empty")
+	}
+
+	total := sdk.ZeroDec()
+	targets := make(map[string]bool)
+	for _, t := range distribution {
+		if strings.TrimSpace(t.Target) == "" {
+			return sdkerrors.Wrap(ErrInvalidDistribution, "distribution target should not be blank")
+		}
+		if reservedTargets[t.Target] {
+			return sdkerrors.Wrapf(ErrInvalidDistribution, "module account [%s] cannot be a distribution target", t.Target)
+		}
+		if targets[t.Target] {
+			return sdkerrors.Wrapf(ErrInvalidDistribution, "duplicate distribution target [%s]", t.Target)
+		}
+		targets[t.Target] = true
+
+		if t.Weight.IsNil() || !t.Weight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidDistribution, "weight of distribution target [%s] should be positive", t.Target)
+		}
+		total = total.Add(t.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidDistribution, "distribution weights should sum to 1, got [%s]", total)
+	}
+	return nil
+}
+
+// Split splits the coins by the weights of the distribution. The last target
+// receives the remainder, so that the shares always sum to the coins
+func Split(distribution []DistributionTarget, coins sdk.Coins) []sdk.Coins {
+	shares := make([]sdk.Coins, len(distribution))
+	remaining := coins
+	for i, t := range distribution {
+		if i == len(distribution)-1 {
+			shares[i] = remaining
+			break
+		}
+
+		var share sdk.Coins
+		for _, coin := range coins {
+			share = share.Add(sdk.NewCoin(coin.Denom, t.Weight.MulInt(coin.Amount).TruncateInt()))
+		}
+		shares[i] = share
+		remaining = remaining.Sub(share)
+	}
+	return shares
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateDistribution(t *testing.T) {
+	tests := []struct {
+		name         string
+		distribution []DistributionTarget
+		expectPass   bool
+	}{
+		{"default distribution", DefaultDistribution(), true},
+		{"split distribution", []DistributionTarget{
+			NewDistributionTarget("fee_collector", sdk.NewDecWithPrec(7, 1)),
+			NewDistributionTarget(CommunityPoolTarget, sdk.NewDecWithPrec(3, 1)),
+		}, true},
+		{"empty distribution", nil, false},
+		{"blank target", []DistributionTarget{NewDistributionTarget(" ", sdk.OneDec())}, false},
+		{"duplicate target", []DistributionTarget{
+			NewDistributionTarget("fee_collector", sdk.NewDecWithPrec(5, 1)),
+			NewDistributionTarget("fee_collector", sdk.NewDecWithPrec(5, 1)),
+		}, false},
+		{"zero weight", []DistributionTarget{
+			NewDistributionTarget("fee_collector", sdk.OneDec()),
+			NewDistributionTarget(CommunityPoolTarget, sdk.ZeroDec()),
+		}, false},
+		{"nil weight", []DistributionTarget{{Target: "fee_collector"}}, false},
+		{"mint module", []DistributionTarget{NewDistributionTarget(ModuleName, sdk.OneDec())}, false},
+		{"bonded pool", []DistributionTarget{NewDistributionTarget("bonded_tokens_pool", sdk.OneDec())}, false},
+		{"not bonded pool", []DistributionTarget{NewDistributionTarget("not_bonded_tokens_pool", sdk.OneDec())}, false},
+		{"distribution module", []DistributionTarget{NewDistributionTarget("distribution", sdk.OneDec())}, false},
+		{"gov module", []DistributionTarget{NewDistributionTarget("gov", sdk.OneDec())}, false},
+		{"weights below 1", []DistributionTarget{NewDistributionTarget("fee_collector", sdk.NewDecWithPrec(9, 1))}, false},
+		{"weights above 1", []DistributionTarget{
+			NewDistributionTarget("fee_collector", sdk.NewDecWithPrec(7, 1)),
+			NewDistributionTarget(CommunityPoolTarget, sdk.NewDecWithPrec(4, 1)),
+		}, false},
+	}
+	for _, tc := range tests {
+		err := ValidateDistribution(tc.distribution)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	distribution := []DistributionTarget{
+		NewDistributionTarget("fee_collector", sdk.NewDecWithPrec(1, 1)),
+		NewDistributionTarget(CommunityPoolTarget, sdk.NewDecWithPrec(2, 1)),
+		NewDistributionTarget("other", sdk.NewDecWithPrec(7, 1)),
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1005)))
+
+	shares := Split(distribution, coins)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(201))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(704))),
+	}, shares)
+
+	// shares always sum to the coins
+	total := sdk.NewCoins()
+	for _, share := range shares {
+		total = total.Add(share...)
+	}
+	require.Equal(t, coins, total)
+}
@@ -8,4 +8,8 @@ import (
 var (
 	ErrInvalidMintInflation = sdkerrors.Register(ModuleName, 2, "invalid mint inflation")
 	ErrInvalidMintDenom     = sdkerrors.Register(ModuleName, 3, "invalid mint denom")
+	ErrInvalidSchedule      = sdkerrors.Register(ModuleName, 4, "invalid inflation schedule")
+	ErrInvalidDistribution  = sdkerrors.Register(ModuleName, 5, "invalid mint distribution")
+	ErrInvalidInflationBase = sdkerrors.Register(ModuleName, 6, "invalid inflation base")
+	ErrInvalidIssuance      = sdkerrors.Register(ModuleName, 7, "invalid issuance")
 )
@@ -3,9 +3,16 @@ package types
 
 // mint module event types
 const (
-	EventTypeMint = "mint"
+	EventTypeMint             = "mint"
+	EventTypeMaxSupplyReached = "max_supply_reached"
+	EventTypeUpdateMintBase   = "update_mint_base"
 
 	AttributeKeyLastInflationTime = "last_inflation_time"
 	AttributeKeyInflationTime     = "inflation_time"
 	AttributeKeyMintCoin          = "mint_coin"
+	AttributeKeyInflation         = "inflation"
+	AttributeKeyShare             = "share"
+	AttributeKeyDenom             = "denom"
+	AttributeKeyMaxSupply         = "max_supply"
+	AttributeKeyInflationBase     = "inflation_base"
 )
@@ -3,6 +3,9 @@ package types // noalias
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
 )
 
 // accountKeeper defines the contract required for account APIs.
@@ -20,4 +23,21 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	GetSupply(ctx sdk.Context) bankexported.SupplyI
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// StakingKeeper defines the expected staking keeper
+type StakingKeeper interface {
+	BondedRatio(ctx sdk.Context) sdk.Dec
+}
+
+// DistrKeeper defines the expected distribution keeper
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// TokenKeeper defines the expected token keeper
+type TokenKeeper interface {
+	GetToken(ctx sdk.Context, denom string) (tokentypes.TokenI, error)
 }
@@ -1,10 +1,11 @@
 package types
 
 // NewGenesisState constructs a GenesisState
-func NewGenesisState(minter Minter, params Params) *GenesisState {
+func NewGenesisState(minter Minter, params Params, issuances []Issuance) *GenesisState {
 	return &GenesisState{
-		Minter: minter,
-		Params: params,
+		Minter:    minter,
+		Params:    params,
+		Issuances: issuances,
 	}
 }
 
@@ -22,5 +23,8 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	if err := ValidateIssuances(data.Issuances); err != nil {
+		return err
+	}
 	return ValidateMinter(data.Minter)
 }
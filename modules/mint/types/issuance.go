@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewIssuance creates a new issuance of an epoch starting at the given block
+func NewIssuance(startHeight int64, startTime time.Time, amount sdk.Coins) Issuance {
+	return Issuance{
+		StartHeight: startHeight,
+		EndHeight:   startHeight,
+		StartTime:   startTime,
+		EndTime:     startTime,
+		Amount:      amount,
+	}
+}
+
+// Overlaps returns true if the epoch overlaps with the given height and time range. A zero
+// height or a nil time leaves the range unbounded on that side
+func (i Issuance) Overlaps(startHeight, endHeight int64, startTime, endTime *time.Time) bool {
+	if startHeight > 0 && i.EndHeight < startHeight {
+		return false
+	}
+	if endHeight > 0 && i.StartHeight > endHeight {
+		return false
+	}
+	if startTime != nil && i.EndTime.Before(*startTime) {
+		return false
+	}
+	if endTime != nil && i.StartTime.After(*endTime) {
+		return false
+	}
+	return true
+}
+
+// Validate returns err if the issuance is invalid
+func (i Issuance) Validate() error {
+	if i.StartHeight <= 0 {
+		return fmt.Errorf("issuance start height (%d) must be positive", i.StartHeight)
+	}
+	if i.EndHeight < i.StartHeight {
+		return fmt.Errorf("issuance end height (%d) must not be before the start height (%d)", i.EndHeight, i.StartHeight)
+	}
+	if i.EndTime.Before(i.StartTime) {
+		return fmt.Errorf("issuance end time (%s) must not be before the start time (%s)", i.EndTime, i.StartTime)
+	}
+	if !i.Amount.IsValid() {
+		return fmt.Errorf("invalid issuance amount: %s", i.Amount)
+	}
+	return nil
+}
+
+// ValidateIssuances returns err if an issuance is invalid, or if the epochs are not
+// ordered by height without overlapping
+func ValidateIssuances(issuances []Issuance) error {
+	for idx, issuance := range issuances {
+		if err := issuance.Validate(); err != nil {
+			return err
+		}
+		if idx > 0 && issuance.StartHeight <= issuances[idx-1].EndHeight {
+			return fmt.Errorf("issuance starting at height %d overlaps with the previous one", issuance.StartHeight)
+		}
+	}
+	return nil
+}
+
+// QueryIssuancesParams defines the params for the legacy issuances query
+type QueryIssuancesParams struct {
+	StartHeight int64      `json:"start_height" yaml:"start_height"`
+	EndHeight   int64      `json:"end_height" yaml:"end_height"`
+	StartTime   *time.Time `json:"start_time" yaml:"start_time"`
+	EndTime     *time.Time `json:"end_time" yaml:"end_time"`
+	Page        int        `json:"page" yaml:"page"`
+	Limit       int        `json:"limit" yaml:"limit"`
+}
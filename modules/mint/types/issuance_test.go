@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestIssuanceOverlaps(t *testing.T) {
+	startTime := time.Unix(1600000000, 0).UTC()
+	issuance := NewIssuance(10, startTime, sdk.NewCoins())
+	issuance.EndHeight = 19
+	issuance.EndTime = startTime.Add(time.Hour)
+
+	before := startTime.Add(-time.Minute)
+	within := startTime.Add(time.Minute)
+	after := startTime.Add(2 * time.Hour)
+
+	tests := []struct {
+		startHeight, endHeight int64
+		startTime, endTime     *time.Time
+		expected               bool
+	}{
+		{0, 0, nil, nil, true},
+		{19, 0, nil, nil, true},
+		{20, 0, nil, nil, false},
+		{0, 10, nil, nil, true},
+		{0, 9, nil, nil, false},
+		{0, 0, &within, nil, true},
+		{0, 0, &after, nil, false},
+		{0, 0, nil, &within, true},
+		{0, 0, nil, &before, false},
+		{5, 15, &before, &within, true},
+		{5, 15, &after, nil, false},
+	}
+	for i, tc := range tests {
+		require.Equal(t, tc.expected, issuance.Overlaps(tc.startHeight, tc.endHeight, tc.startTime, tc.endTime), "%d", i)
+	}
+}
+
+func TestValidateIssuances(t *testing.T) {
+	startTime := time.Unix(1600000000, 0).UTC()
+	coins := sdk.NewCoins(sdk.NewCoin(MintDenom, sdk.NewInt(10)))
+
+	first := NewIssuance(2, startTime, coins)
+	first.EndHeight = 11
+	second := NewIssuance(12, startTime.Add(time.Minute), coins)
+
+	require.NoError(t, ValidateIssuances(nil))
+	require.NoError(t, ValidateIssuances([]Issuance{first, second}))
+	require.Error(t, ValidateIssuances([]Issuance{second, first}))
+	require.Error(t, ValidateIssuances([]Issuance{first, NewIssuance(11, startTime, coins)}))
+	require.Error(t, ValidateIssuances([]Issuance{NewIssuance(0, startTime, coins)}))
+
+	invalid := first
+	invalid.EndTime = startTime.Add(-time.Second)
+	require.Error(t, ValidateIssuances([]Issuance{invalid}))
+
+	invalid = first
+	invalid.Amount = sdk.Coins{sdk.Coin{Denom: MintDenom, Amount: sdk.NewInt(-1)}}
+	require.Error(t, ValidateIssuances([]Issuance{invalid}))
+}
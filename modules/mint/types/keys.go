@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // nolint
 const (
 	// ModuleName defines the module name
@@ -15,11 +19,25 @@ const (
 	QuerierRoute = ModuleName
 
 	// Query endpoints supported by the minting querier
-	QueryParameters = "parameters"
-	QueryInflation  = "inflation"
+	QueryParameters       = "parameters"
+	QueryInflation        = "inflation"
+	QueryMinter           = "minter"
+	QueryAnnualProvisions = "annual_provisions"
+	QueryBlockProvision   = "block_provision"
+	QueryIssuances        = "issuances"
 )
 
 var (
 	// use for the keeper store
-	MinterKey = []byte{0x00}
+	MinterKey         = []byte{0x00}
+	BlockProvisionKey = []byte{0x01}
+	InflationBaseKey  = []byte{0x02}
+
+	// prefix of the issuance ledger, keyed by the start height of each epoch
+	IssuanceKeyPrefix = []byte{0x03}
 )
+
+// GetIssuanceKey returns the key of the issuance of the epoch starting at the given height
+func GetIssuanceKey(startHeight int64) []byte {
+	return append(IssuanceKeyPrefix, sdk.Uint64ToBigEndian(uint64(startHeight))...)
+}
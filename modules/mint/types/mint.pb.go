@@ -6,9 +6,11 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
@@ -28,12 +30,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationMode defines how the inflation rate is determined
+type InflationMode int32
+
+const (
+	// FLAT defines a flat inflation rate following the schedule
+	Flat InflationMode = 0
+	// BONDED_RATIO defines an inflation rate moving toward the goal bonded ratio
+	BondedRatio InflationMode = 1
+)
+
+var InflationMode_name = map[int32]string{
+	0: "FLAT",
+	1: "BONDED_RATIO",
+}
+
+var InflationMode_value = map[string]int32{
+	"FLAT":         0,
+	"BONDED_RATIO": 1,
+}
+
+func (x InflationMode) String() string {
+	return proto.EnumName(InflationMode_name, int32(x))
+}
+
+func (InflationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// time which the last update was made to the minter
 	LastUpdate time.Time `protobuf:"bytes,1,opt,name=last_update,json=lastUpdate,proto3,stdtime" json:"last_update" yaml:"last_update"`
 	// base inflation
 	InflationBase github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=inflation_base,json=inflationBase,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflation_base" yaml:"inflation_base"`
+	// current inflation rate
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -82,6 +114,26 @@ type Params struct {
 	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
 	// inflation rate
 	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// inflation schedule, overrides the inflation rate once the first step is reached
+	Schedule []InflationStep `protobuf:"bytes,3,rep,name=schedule,proto3" json:"schedule"`
+	// inflation mode
+	Mode InflationMode `protobuf:"varint,4,opt,name=mode,proto3,enum=irishub.mint.InflationMode" json:"mode,omitempty"`
+	// minimum inflation rate of the bonded ratio mode
+	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min" yaml:"inflation_min"`
+	// maximum inflation rate of the bonded ratio mode
+	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max" yaml:"inflation_max"`
+	// goal of percent bonded atoms of the bonded ratio mode
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
+	// maximum annual change in inflation rate of the bonded ratio mode
+	InflationRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate_change" yaml:"inflation_rate_change"`
+	// distribution of the minted coins
+	Distribution []DistributionTarget `protobuf:"bytes,9,rep,name=distribution,proto3" json:"distribution"`
+	// number of blocks of an epoch of the issuance ledger
+	IssuanceEpoch uint64 `protobuf:"varint,10,opt,name=issuance_epoch,json=issuanceEpoch,proto3" json:"issuance_epoch,omitempty" yaml:"issuance_epoch"`
+	// number of epochs kept in the issuance ledger, 0 keeps all of them
+	IssuanceRetention uint64 `protobuf:"varint,11,opt,name=issuance_retention,json=issuanceRetention,proto3" json:"issuance_retention,omitempty" yaml:"issuance_retention"`
+	// longest BFT time span a single block can claim provisions for
+	MaxProvisionDuration time.Duration `protobuf:"bytes,12,opt,name=max_provision_duration,json=maxProvisionDuration,proto3,stdduration" json:"max_provision_duration" yaml:"max_provision_duration"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -123,38 +175,361 @@ func (m *Params) GetMintDenom() string {
 	return ""
 }
 
+func (m *Params) GetSchedule() []InflationStep {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *Params) GetMode() InflationMode {
+	if m != nil {
+		return m.Mode
+	}
+	return Flat
+}
+
+func (m *Params) GetDistribution() []DistributionTarget {
+	if m != nil {
+		return m.Distribution
+	}
+	return nil
+}
+
+func (m *Params) GetIssuanceEpoch() uint64 {
+	if m != nil {
+		return m.IssuanceEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetIssuanceRetention() uint64 {
+	if m != nil {
+		return m.IssuanceRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxProvisionDuration() time.Duration {
+	if m != nil {
+		return m.MaxProvisionDuration
+	}
+	return 0
+}
+
+// DistributionTarget defines a share of the minted coins
+type DistributionTarget struct {
+	// name of the target module account, or community_pool
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// weight of the share
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *DistributionTarget) Reset()         { *m = DistributionTarget{} }
+func (m *DistributionTarget) String() string { return proto.CompactTextString(m) }
+func (*DistributionTarget) ProtoMessage()    {}
+func (*DistributionTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{2}
+}
+func (m *DistributionTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionTarget.Merge(m, src)
+}
+func (m *DistributionTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionTarget proto.InternalMessageInfo
+
+func (m *DistributionTarget) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+// InflationStep defines an inflation rate which takes effect from a start time or a start height
+type InflationStep struct {
+	// time from which the step takes effect
+	StartTime *time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty" yaml:"start_time"`
+	// height from which the step takes effect
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// inflation rate of the step
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// annual exponential decay of the rate since the start time of the step
+	Decay github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=decay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay"`
+}
+
+func (m *InflationStep) Reset()         { *m = InflationStep{} }
+func (m *InflationStep) String() string { return proto.CompactTextString(m) }
+func (*InflationStep) ProtoMessage()    {}
+func (*InflationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{3}
+}
+func (m *InflationStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationStep.Merge(m, src)
+}
+func (m *InflationStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationStep proto.InternalMessageInfo
+
+func (m *InflationStep) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *InflationStep) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// Issuance defines the coins minted during an epoch
+type Issuance struct {
+	// height of the first block of the epoch
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// height of the last block minted in the epoch
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	// time of the first block of the epoch
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// time of the last block minted in the epoch
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// coins minted during the epoch
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Issuance) Reset()         { *m = Issuance{} }
+func (m *Issuance) String() string { return proto.CompactTextString(m) }
+func (*Issuance) ProtoMessage()    {}
+func (*Issuance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{4}
+}
+func (m *Issuance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Issuance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Issuance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Issuance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Issuance.Merge(m, src)
+}
+func (m *Issuance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Issuance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Issuance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Issuance proto.InternalMessageInfo
+
+func (m *Issuance) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Issuance) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Issuance) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Issuance) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *Issuance) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MintBaseUpdateProposal defines a proposal to update the inflation base of the minter,
+// either to the given base or to the current supply of the mint denom
+type MintBaseUpdateProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// new inflation base, must be empty when derived from the supply
+	InflationBase github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=inflation_base,json=inflationBase,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflation_base" yaml:"inflation_base"`
+	// whether the inflation base is derived from the current supply of the mint denom
+	FromSupply bool `protobuf:"varint,4,opt,name=from_supply,json=fromSupply,proto3" json:"from_supply,omitempty" yaml:"from_supply"`
+}
+
+func (m *MintBaseUpdateProposal) Reset()      { *m = MintBaseUpdateProposal{} }
+func (*MintBaseUpdateProposal) ProtoMessage() {}
+func (*MintBaseUpdateProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{5}
+}
+func (m *MintBaseUpdateProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintBaseUpdateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintBaseUpdateProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintBaseUpdateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintBaseUpdateProposal.Merge(m, src)
+}
+func (m *MintBaseUpdateProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintBaseUpdateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintBaseUpdateProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintBaseUpdateProposal proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("irishub.mint.InflationMode", InflationMode_name, InflationMode_value)
 	proto.RegisterType((*Minter)(nil), "irishub.mint.Minter")
 	proto.RegisterType((*Params)(nil), "irishub.mint.Params")
+	proto.RegisterType((*DistributionTarget)(nil), "irishub.mint.DistributionTarget")
+	proto.RegisterType((*InflationStep)(nil), "irishub.mint.InflationStep")
+	proto.RegisterType((*Issuance)(nil), "irishub.mint.Issuance")
+	proto.RegisterType((*MintBaseUpdateProposal)(nil), "irishub.mint.MintBaseUpdateProposal")
 }
 
 func init() { proto.RegisterFile("mint/mint.proto", fileDescriptor_e1b9fbb701b2a577) }
 
 var fileDescriptor_e1b9fbb701b2a577 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x33, 0x97, 0x4b, 0x21, 0xd3, 0x7b, 0x15, 0x82, 0x42, 0x29, 0x98, 0x94, 0x2c, 0xa4,
-	0x9b, 0xce, 0x80, 0xee, 0xba, 0x0c, 0x85, 0x22, 0x28, 0x48, 0xd0, 0x8d, 0x2e, 0xca, 0xa4, 0x99,
-	0xc6, 0xc1, 0xcc, 0x4c, 0xc8, 0x4c, 0x16, 0xc5, 0x97, 0xe8, 0xd2, 0xa5, 0x8f, 0xd3, 0x65, 0x37,
-	0x82, 0xb8, 0x88, 0xd2, 0xbe, 0x41, 0x9f, 0x40, 0x26, 0x69, 0x6b, 0x5d, 0xba, 0x49, 0xe6, 0xfc,
-	0x73, 0xce, 0x37, 0xe7, 0x9c, 0x1f, 0x1e, 0x72, 0x26, 0x34, 0x36, 0x1f, 0x94, 0xe5, 0x52, 0x4b,
-	0xe7, 0x1f, 0xcb, 0x99, 0x7a, 0x28, 0x22, 0x64, 0xb4, 0xf6, 0x51, 0x22, 0x13, 0x59, 0x5d, 0x60,
-	0x73, 0xaa, 0x73, 0xda, 0x5e, 0x22, 0x65, 0x92, 0x52, 0x5c, 0x45, 0x51, 0x31, 0xc1, 0x9a, 0x71,
-	0xaa, 0x34, 0xe1, 0x59, 0x9d, 0xe0, 0xbf, 0x02, 0xd8, 0xb8, 0x62, 0x42, 0xd3, 0xdc, 0xb9, 0x87,
-	0xcd, 0x94, 0x28, 0x3d, 0x2a, 0xb2, 0x98, 0x68, 0xda, 0x02, 0x1d, 0xd0, 0x6d, 0x9e, 0xb5, 0x51,
-	0x4d, 0x40, 0x5b, 0x02, 0xba, 0xd9, 0x12, 0x02, 0x77, 0x5e, 0x7a, 0xd6, 0xba, 0xf4, 0x9c, 0x29,
-	0xe1, 0x69, 0xdf, 0xdf, 0x2b, 0xf6, 0x67, 0x1f, 0x1e, 0x08, 0xa1, 0x51, 0x6e, 0x2b, 0xc1, 0x11,
-	0xf0, 0x80, 0x89, 0x49, 0x4a, 0x34, 0x93, 0x62, 0x14, 0x11, 0x45, 0x5b, 0x7f, 0x3a, 0xa0, 0x6b,
-	0x07, 0x43, 0xc3, 0x78, 0x2f, 0xbd, 0xd3, 0x84, 0x69, 0x33, 0xcb, 0x58, 0x72, 0x3c, 0x96, 0x8a,
-	0x4b, 0xb5, 0xf9, 0xf5, 0x54, 0xfc, 0x88, 0xf5, 0x34, 0xa3, 0x0a, 0x5d, 0x08, 0xbd, 0x2e, 0xbd,
-	0xe3, 0xfa, 0xb5, 0x9f, 0x34, 0x3f, 0xfc, 0xbf, 0x13, 0x02, 0x13, 0x3f, 0xc1, 0xc6, 0x35, 0xc9,
-	0x09, 0x57, 0xce, 0x09, 0x84, 0x66, 0x41, 0xa3, 0x98, 0x0a, 0xc9, 0xab, 0xa9, 0xec, 0xd0, 0x36,
-	0xca, 0xc0, 0x08, 0xce, 0x25, 0xb4, 0x77, 0x95, 0x9b, 0x9e, 0xd0, 0x2f, 0x7a, 0x1a, 0xd0, 0x71,
-	0xf8, 0x0d, 0xe8, 0xff, 0x7d, 0x7e, 0xf1, 0xac, 0x60, 0x38, 0x5f, 0xba, 0x60, 0xb1, 0x74, 0xc1,
-	0xe7, 0xd2, 0x05, 0xb3, 0x95, 0x6b, 0x2d, 0x56, 0xae, 0xf5, 0xb6, 0x72, 0xad, 0xbb, 0xde, 0x1e,
-	0xd2, 0xd8, 0x27, 0xa8, 0xc6, 0x1b, 0x1b, 0x31, 0x97, 0x71, 0x91, 0x52, 0x55, 0x59, 0x5c, 0xd3,
-	0xa3, 0x46, 0xb5, 0xf5, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x4e, 0xb8, 0x09, 0xfc,
-	0x01, 0x00, 0x00,
+	// 1065 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xc6, 0x1b, 0xd7, 0x7e, 0x76, 0x92, 0x76, 0x9a, 0xa4, 0x1b, 0x97, 0x78, 0xcd, 0x1e,
+	0x90, 0x41, 0xea, 0x9a, 0x16, 0x24, 0xa4, 0x48, 0x48, 0x74, 0xeb, 0xa6, 0x04, 0x25, 0x6d, 0xb4,
+	0x0d, 0x12, 0x82, 0xc3, 0x6a, 0xbc, 0x3b, 0xb1, 0x57, 0xf5, 0xce, 0x58, 0x3b, 0xe3, 0x92, 0x70,
+	0xe4, 0x42, 0xe9, 0x85, 0x1e, 0x7b, 0xa9, 0x14, 0xa9, 0x37, 0xf8, 0x22, 0x3d, 0xf6, 0x88, 0x38,
+	0xb8, 0x28, 0xb9, 0x70, 0xce, 0x27, 0x40, 0x33, 0xbb, 0xf6, 0xda, 0x49, 0x51, 0x9a, 0x00, 0x97,
+	0xc4, 0xef, 0xdf, 0xef, 0x37, 0xf3, 0xde, 0xdb, 0xf7, 0x06, 0x16, 0xa2, 0x90, 0x8a, 0xa6, 0xfc,
+	0x63, 0xf7, 0x63, 0x26, 0x18, 0xaa, 0x84, 0x71, 0xc8, 0xbb, 0x83, 0xb6, 0x2d, 0x75, 0xd5, 0xc5,
+	0x0e, 0xeb, 0x30, 0x65, 0x68, 0xca, 0x5f, 0x89, 0x4f, 0xd5, 0xec, 0x30, 0xd6, 0xe9, 0x91, 0xa6,
+	0x92, 0xda, 0x83, 0xdd, 0xa6, 0x08, 0x23, 0xc2, 0x05, 0x8e, 0xfa, 0xa9, 0x43, 0xed, 0xa4, 0x43,
+	0x30, 0x88, 0xb1, 0x08, 0x19, 0x4d, 0xed, 0xd7, 0x7c, 0xc6, 0x23, 0xc6, 0xbd, 0x04, 0xd9, 0x67,
+	0x61, 0x6a, 0xb0, 0x5e, 0xce, 0x40, 0x61, 0x2b, 0xa4, 0x82, 0xc4, 0xe8, 0x3b, 0x28, 0xf7, 0x30,
+	0x17, 0xde, 0xa0, 0x1f, 0x60, 0x41, 0x0c, 0xad, 0xae, 0x35, 0xca, 0xb7, 0xaa, 0x76, 0x82, 0x6c,
+	0x8f, 0x90, 0xed, 0x9d, 0x11, 0xb5, 0x53, 0x7b, 0x35, 0x34, 0x73, 0xc7, 0x43, 0x13, 0xed, 0xe3,
+	0xa8, 0xb7, 0x66, 0x4d, 0x04, 0x5b, 0xcf, 0xde, 0x98, 0x9a, 0x0b, 0x52, 0xf3, 0xb5, 0x52, 0x20,
+	0x0a, 0xf3, 0x21, 0xdd, 0xed, 0xa9, 0x33, 0x79, 0x6d, 0xcc, 0x89, 0x31, 0x53, 0xd7, 0x1a, 0x25,
+	0xe7, 0x9e, 0xc4, 0xf8, 0x63, 0x68, 0x7e, 0xd0, 0x09, 0x85, 0x4c, 0x82, 0xcf, 0xa2, 0x66, 0x72,
+	0xd6, 0xf4, 0xdf, 0x0d, 0x1e, 0x3c, 0x6a, 0x8a, 0xfd, 0x3e, 0xe1, 0xf6, 0x06, 0x15, 0xc7, 0x43,
+	0x73, 0x29, 0x61, 0x9b, 0x46, 0xb3, 0xdc, 0xb9, 0xb1, 0xc2, 0xc1, 0x9c, 0xa0, 0x4d, 0x28, 0x8d,
+	0x15, 0x46, 0x5e, 0x51, 0xd9, 0xe7, 0xa0, 0x6a, 0x11, 0xdf, 0xcd, 0x00, 0xac, 0x9f, 0x8b, 0x50,
+	0xd8, 0xc6, 0x31, 0x8e, 0x38, 0x5a, 0x05, 0x90, 0x85, 0xf2, 0x02, 0x42, 0x59, 0xa4, 0x92, 0x54,
+	0x72, 0x4b, 0x52, 0xd3, 0x92, 0x8a, 0x69, 0xde, 0x99, 0x7f, 0xc9, 0x8b, 0x3e, 0x87, 0x22, 0xf7,
+	0xbb, 0x24, 0x18, 0xf4, 0x88, 0x91, 0xaf, 0xe7, 0x1b, 0xe5, 0x5b, 0xd7, 0xed, 0xc9, 0x76, 0xb1,
+	0x37, 0x46, 0xae, 0x0f, 0x05, 0xe9, 0x3b, 0xba, 0x64, 0x72, 0xc7, 0x21, 0xa8, 0x09, 0x7a, 0xc4,
+	0x02, 0x62, 0xe8, 0x75, 0xad, 0x31, 0xff, 0x8f, 0xa1, 0x5b, 0x2c, 0x20, 0xae, 0x72, 0x44, 0x8f,
+	0x20, 0x4b, 0xa3, 0x17, 0x85, 0xd4, 0x98, 0x55, 0x37, 0x58, 0x3f, 0xdf, 0x0d, 0x8e, 0x87, 0xe6,
+	0xe2, 0xc9, 0x22, 0x45, 0x21, 0xb5, 0xdc, 0xca, 0x58, 0xde, 0x0a, 0xe9, 0x09, 0x32, 0xbc, 0x67,
+	0x14, 0xfe, 0x33, 0x32, 0xbc, 0x37, 0x45, 0x86, 0xf7, 0x10, 0x81, 0x72, 0x87, 0xe1, 0x9e, 0xd7,
+	0x66, 0x34, 0x20, 0x81, 0x71, 0x49, 0x51, 0xb5, 0xce, 0x4d, 0x95, 0xb6, 0xfa, 0x04, 0x94, 0xe5,
+	0x82, 0x94, 0x1c, 0x25, 0xa0, 0x1f, 0x35, 0x58, 0xca, 0xce, 0x11, 0x63, 0x41, 0x3c, 0xbf, 0x8b,
+	0x69, 0x87, 0x18, 0x45, 0xc5, 0x78, 0xff, 0xdc, 0x8c, 0xef, 0x9d, 0xbc, 0xdc, 0x04, 0xa8, 0xe5,
+	0x5e, 0x1d, 0xeb, 0x5d, 0x2c, 0xc8, 0x1d, 0xa5, 0x45, 0x5f, 0x41, 0x25, 0x08, 0xb9, 0x88, 0xc3,
+	0xf6, 0x40, 0xb5, 0x61, 0x49, 0x75, 0x4e, 0x7d, 0xba, 0xfc, 0xad, 0x09, 0x8f, 0x1d, 0x1c, 0x77,
+	0x88, 0x48, 0xdb, 0x67, 0x2a, 0x16, 0x7d, 0x01, 0xf3, 0x21, 0xe7, 0x03, 0x4c, 0x7d, 0xe2, 0x91,
+	0x3e, 0xf3, 0xbb, 0x06, 0xd4, 0xb5, 0x86, 0xee, 0xac, 0x4c, 0x7c, 0x89, 0x53, 0x76, 0xf9, 0x25,
+	0xa6, 0x8a, 0xbb, 0x52, 0x46, 0x9b, 0x80, 0xc6, 0x1e, 0x31, 0x11, 0x84, 0xaa, 0x33, 0x95, 0x15,
+	0xca, 0xea, 0xf1, 0xd0, 0x5c, 0x39, 0x81, 0x32, 0xf6, 0xb1, 0xdc, 0x2b, 0x23, 0xa5, 0x3b, 0xd2,
+	0xa1, 0x1f, 0x60, 0x39, 0xc2, 0x7b, 0x72, 0x8e, 0x3d, 0x0e, 0xb9, 0x4c, 0xc7, 0x68, 0xd0, 0x19,
+	0x15, 0x35, 0xaf, 0x56, 0x4e, 0xcd, 0xab, 0x56, 0xea, 0xe0, 0x7c, 0x98, 0x8e, 0xab, 0xd5, 0x84,
+	0xf0, 0xed, 0x30, 0xd6, 0x73, 0x39, 0xb9, 0x16, 0x23, 0xbc, 0xb7, 0x3d, 0xb2, 0x8d, 0x00, 0xd6,
+	0xf4, 0xe7, 0x07, 0x66, 0xce, 0x12, 0x80, 0x4e, 0xe7, 0x0e, 0x2d, 0x43, 0x41, 0xa8, 0x5f, 0xe9,
+	0x48, 0x48, 0x25, 0xb4, 0x0e, 0x85, 0xef, 0x49, 0xd8, 0xe9, 0x8a, 0x0b, 0x0e, 0x83, 0x34, 0xda,
+	0xfa, 0x6d, 0x06, 0xe6, 0xa6, 0x3e, 0x76, 0xb4, 0x03, 0xc0, 0x05, 0x8e, 0x85, 0x27, 0x77, 0xc1,
+	0x3b, 0x4c, 0x6b, 0x59, 0xb1, 0x2b, 0xc9, 0xd5, 0xb3, 0xb8, 0x64, 0x50, 0x97, 0x94, 0x42, 0xba,
+	0xa2, 0x35, 0xa8, 0x24, 0xd6, 0x6e, 0x76, 0xea, 0xbc, 0x73, 0xed, 0x78, 0x68, 0x5e, 0x9d, 0x8c,
+	0x4d, 0xac, 0x96, 0x5b, 0x56, 0xe2, 0x97, 0x4a, 0x42, 0x0e, 0xe8, 0xb2, 0x39, 0x2f, 0x38, 0x6e,
+	0x55, 0x2c, 0x6a, 0xc1, 0x6c, 0x40, 0x7c, 0xbc, 0x6f, 0xe8, 0x17, 0x02, 0x49, 0x82, 0xad, 0x5f,
+	0xf2, 0x50, 0xdc, 0x48, 0x7b, 0xe7, 0xd4, 0x95, 0xb4, 0x73, 0x5c, 0xe9, 0x53, 0x00, 0x42, 0x83,
+	0xe9, 0x64, 0x2c, 0x65, 0x89, 0xcc, 0x6c, 0x96, 0x5b, 0x22, 0x34, 0x48, 0xa3, 0xbe, 0x99, 0x2a,
+	0x4d, 0xfe, 0xcc, 0xd2, 0xac, 0xa6, 0x9d, 0x79, 0x76, 0x79, 0x5c, 0x28, 0x4a, 0x4e, 0x85, 0xab,
+	0x9f, 0x89, 0x7b, 0x3d, 0xc5, 0x5d, 0xc8, 0x4e, 0x9b, 0xa1, 0x5e, 0x22, 0x34, 0x50, 0x98, 0x3e,
+	0x14, 0x70, 0xc4, 0x06, 0x54, 0x18, 0xb3, 0x6a, 0x50, 0xac, 0xd8, 0x49, 0x6a, 0x6d, 0xb9, 0x58,
+	0xed, 0xc7, 0x37, 0xdb, 0x44, 0xe0, 0x9b, 0xf6, 0x1d, 0x16, 0x52, 0xe7, 0x63, 0x09, 0xf8, 0xeb,
+	0x1b, 0xb3, 0xf1, 0x0e, 0xe5, 0x90, 0x01, 0xdc, 0x4d, 0xa1, 0xad, 0x9f, 0x66, 0x60, 0x59, 0xbe,
+	0x33, 0xe4, 0x72, 0x4e, 0x9e, 0x04, 0xdb, 0x31, 0xeb, 0x33, 0x8e, 0x7b, 0x68, 0x11, 0x66, 0x45,
+	0x28, 0x7a, 0x24, 0xfd, 0x72, 0x12, 0x01, 0xd5, 0xa1, 0x1c, 0x10, 0xee, 0xc7, 0x61, 0x3f, 0x5b,
+	0xa5, 0xee, 0xa4, 0xea, 0x2d, 0x4f, 0x8a, 0xfc, 0xff, 0xfa, 0xa4, 0xf8, 0x0c, 0xca, 0xbb, 0x31,
+	0x8b, 0x3c, 0x3e, 0xe8, 0xf7, 0x7b, 0x49, 0x83, 0x16, 0x9d, 0xe5, 0x6c, 0x29, 0x4c, 0x18, 0x2d,
+	0x17, 0xa4, 0xf4, 0x50, 0x09, 0x6b, 0x95, 0x27, 0x07, 0x66, 0x4e, 0xce, 0x8e, 0xbf, 0x0e, 0xcc,
+	0xdc, 0x47, 0x9b, 0x30, 0x37, 0xb5, 0x7a, 0x11, 0x02, 0x7d, 0x7d, 0xf3, 0xf6, 0xce, 0xe5, 0x5c,
+	0xb5, 0xf8, 0xf4, 0x45, 0x5d, 0x5f, 0xef, 0x61, 0x81, 0xde, 0x87, 0x8a, 0xf3, 0xe0, 0x7e, 0xeb,
+	0x6e, 0xcb, 0x73, 0x6f, 0xef, 0x6c, 0x3c, 0xb8, 0xac, 0x55, 0x17, 0x9e, 0xbe, 0xa8, 0x97, 0x93,
+	0x2d, 0xe3, 0xca, 0xd8, 0xaa, 0xfe, 0xe4, 0x65, 0x2d, 0xe7, 0xdc, 0x7b, 0x75, 0x58, 0xd3, 0x5e,
+	0x1f, 0xd6, 0xb4, 0x3f, 0x0f, 0x6b, 0xda, 0xb3, 0xa3, 0x5a, 0xee, 0xf5, 0x51, 0x2d, 0xf7, 0xfb,
+	0x51, 0x2d, 0xf7, 0xed, 0x8d, 0x89, 0xeb, 0xcb, 0xc9, 0x4f, 0x89, 0x68, 0xa6, 0x1b, 0xa0, 0x19,
+	0x31, 0xf9, 0x3e, 0xe0, 0xea, 0x19, 0x9a, 0x64, 0xa2, 0x5d, 0x50, 0xfd, 0xf3, 0xc9, 0xdf, 0x03,
+	0x00, 0xbc, 0x36, 0xd2, 0x7e, 0xa0, 0x0a, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -177,6 +552,16 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.InflationBase.Size()
 		i -= size
@@ -218,72 +603,1358 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxProvisionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxProvisionDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x62
+	if m.IssuanceRetention != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.IssuanceRetention))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IssuanceEpoch != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.IssuanceEpoch))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Mode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Inflation.Size()
 		i -= size
 		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Decay.Size()
+		i -= size
+		if _, err := m.Decay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMint(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Issuance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Issuance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Issuance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMint(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintMint(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.EndHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintBaseUpdateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintBaseUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintBaseUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromSupply {
+		i--
+		if m.FromSupply {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.InflationBase.Size()
+		i -= size
+		if _, err := m.InflationBase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdate)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationBase.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.Mode != 0 {
+		n += 1 + sovMint(uint64(m.Mode))
+	}
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.IssuanceEpoch != 0 {
+		n += 1 + sovMint(uint64(m.IssuanceEpoch))
+	}
+	if m.IssuanceRetention != 0 {
+		n += 1 + sovMint(uint64(m.IssuanceRetention))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxProvisionDuration)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *InflationStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Decay.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Issuance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMint(uint64(m.EndHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintBaseUpdateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.InflationBase.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.FromSupply {
+		n += 2
+	}
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = append(m.Schedule, InflationStep{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= InflationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, DistributionTarget{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceEpoch", wireType)
+			}
+			m.IssuanceEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceRetention", wireType)
+			}
+			m.IssuanceRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProvisionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxProvisionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Decay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdate)
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationBase.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
-
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *Issuance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -306,15 +1977,53 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Issuance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Issuance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -341,15 +2050,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdate, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -359,23 +2068,56 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -403,7 +2145,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *MintBaseUpdateProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -426,15 +2168,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintBaseUpdateProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintBaseUpdateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -462,11 +2204,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -494,10 +2236,62 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSupply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FromSupply = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -8,7 +8,7 @@ import (
 )
 
 const (
-	blocksPerYear = 60 * 60 * 8766 / 5 // 5 second a block, 8766 = 365.25 * 24
+	secondsPerYear = 60 * 60 * 8766 // 8766 = 365.25 * 24
 )
 
 var initialIssue = sdk.NewIntWithDecimal(20, 8)
@@ -18,6 +18,7 @@ func NewMinter(lastUpdate time.Time, inflationBase sdk.Int) Minter {
 	return Minter{
 		LastUpdate:    lastUpdate,
 		InflationBase: inflationBase,
+		Inflation:     sdk.ZeroDec(),
 	}
 }
 
@@ -37,17 +38,58 @@ func ValidateMinter(m Minter) error {
 	if !m.InflationBase.GT(sdk.ZeroInt()) {
 		return fmt.Errorf("minter inflation basement (%s) should be positive", m.InflationBase.String())
 	}
+	if m.Inflation.IsNil() || m.Inflation.IsNegative() {
+		return fmt.Errorf("minter inflation (%s) should not be negative", m.Inflation.String())
+	}
 	return nil
 }
 
-// NextAnnualProvisions gets the provisions for a block based on the annual provisions rate
-func (m Minter) NextAnnualProvisions(params Params) (provisions sdk.Dec) {
-	return params.Inflation.MulInt(m.InflationBase)
+// provisionDuration returns the BFT time elapsed since the last update, capped by the max provision duration
+func (m Minter) provisionDuration(blockTime time.Time, maxDuration time.Duration) time.Duration {
+	elapsed := blockTime.Sub(m.LastUpdate)
+	if elapsed < 0 {
+		return 0
+	}
+	if elapsed > maxDuration {
+		return maxDuration
+	}
+	return elapsed
 }
 
-// BlockProvision gets the provisions for a block based on the annual provisions rate
-func (m Minter) BlockProvision(params Params) sdk.Coin {
-	provisions := m.NextAnnualProvisions(params)
-	blockInflationAmount := provisions.QuoInt(sdk.NewInt(blocksPerYear))
+// NextInflationRate moves the current inflation rate toward the goal bonded ratio, by at most
+// InflationRateChange per year, and bounds it between InflationMin and InflationMax
+func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec, blockTime time.Time) sdk.Dec {
+	// The target annual inflation rate is recalculated for each block. The inflation
+	// is also subject to a rate change (positive or negative) depending on the
+	// distance from the desired ratio (GoalBonded).
+	inflationRateChangePerYear := sdk.OneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange)
+	inflationRateChange := inflationRateChangePerYear.
+		MulInt64(int64(m.provisionDuration(blockTime, params.MaxProvisionDuration))).
+		QuoInt64(int64(secondsPerYear * time.Second))
+
+	inflation := m.Inflation.Add(inflationRateChange)
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+	return inflation
+}
+
+// NextAnnualProvisions gets the annual provisions based on the given inflation rate
+func (m Minter) NextAnnualProvisions(inflation sdk.Dec) (provisions sdk.Dec) {
+	return inflation.MulInt(m.InflationBase)
+}
+
+// BlockProvision gets the provisions for a block based on the given inflation rate
+// and the BFT time elapsed since the last update, capped by the max provision duration of the params
+func (m Minter) BlockProvision(params Params, inflation sdk.Dec, blockTime time.Time) sdk.Coin {
+	elapsed := m.provisionDuration(blockTime, params.MaxProvisionDuration)
+
+	provisions := m.NextAnnualProvisions(inflation)
+	blockInflationAmount := provisions.MulInt64(int64(elapsed)).QuoInt64(int64(secondsPerYear * time.Second))
 	return sdk.NewCoin(params.MintDenom, blockInflationAmount.TruncateInt())
 }
@@ -10,18 +10,32 @@ import (
 )
 
 func TestNextInflation(t *testing.T) {
-	minter := NewMinter(time.Now(), sdk.NewIntWithDecimal(100, 18))
-	tests := []struct{ params Params }{
-		{Params{Inflation: sdk.NewDecWithPrec(20, 2), MintDenom: sdk.DefaultBondDenom}},
-		{Params{Inflation: sdk.NewDecWithPrec(10, 2), MintDenom: sdk.DefaultBondDenom}},
-		{Params{Inflation: sdk.NewDecWithPrec(5, 2), MintDenom: sdk.DefaultBondDenom}},
+	lastUpdate := time.Now()
+	minter := NewMinter(lastUpdate, sdk.NewIntWithDecimal(100, 18))
+	tests := []struct {
+		params  Params
+		elapsed time.Duration
+		periods int64
+	}{
+		{Params{Inflation: sdk.NewDecWithPrec(20, 2), MintDenom: sdk.DefaultBondDenom, MaxProvisionDuration: time.Minute}, 5 * time.Second, 12 * 60 * 8766},
+		{Params{Inflation: sdk.NewDecWithPrec(10, 2), MintDenom: sdk.DefaultBondDenom, MaxProvisionDuration: time.Minute}, 5 * time.Second, 12 * 60 * 8766},
+		{Params{Inflation: sdk.NewDecWithPrec(5, 2), MintDenom: sdk.DefaultBondDenom, MaxProvisionDuration: time.Minute}, 5 * time.Second, 12 * 60 * 8766},
+		{Params{Inflation: sdk.NewDecWithPrec(5, 2), MintDenom: sdk.DefaultBondDenom, MaxProvisionDuration: time.Minute}, 10 * time.Second, 6 * 60 * 8766},
+		{Params{Inflation: sdk.NewDecWithPrec(5, 2), MintDenom: sdk.DefaultBondDenom, MaxProvisionDuration: time.Minute}, time.Second, 60 * 60 * 8766},
+		// elapsed time beyond the max provision duration is capped
+		{Params{Inflation: sdk.NewDecWithPrec(5, 2), MintDenom: sdk.DefaultBondDenom, MaxProvisionDuration: time.Minute}, 24 * time.Hour, 60 * 8766},
+		{Params{Inflation: sdk.NewDecWithPrec(5, 2), MintDenom: sdk.DefaultBondDenom, MaxProvisionDuration: 10 * time.Minute}, 24 * time.Hour, 6 * 8766},
 	}
 	for _, tc := range tests {
-		annualProvisions := minter.NextAnnualProvisions(tc.params)
-		mintCoin := minter.BlockProvision(tc.params)
-		blockProvision := annualProvisions.QuoInt(sdk.NewInt(12 * 60 * 8766))
+		annualProvisions := minter.NextAnnualProvisions(tc.params.Inflation)
+		mintCoin := minter.BlockProvision(tc.params, tc.params.Inflation, lastUpdate.Add(tc.elapsed))
+		blockProvision := annualProvisions.QuoInt(sdk.NewInt(tc.periods))
 		require.True(t, mintCoin.Amount.Equal(blockProvision.TruncateInt()), "mint amount:"+mintCoin.Amount.String()+", block provision amount: "+blockProvision.TruncateInt().String())
 	}
+
+	// no provisions for a block time before the last update
+	mintCoin := minter.BlockProvision(tests[0].params, tests[0].params.Inflation, lastUpdate.Add(-time.Second))
+	require.True(t, mintCoin.Amount.IsZero())
 }
 
 func TestDefaultMinter(t *testing.T) {
@@ -49,3 +63,43 @@ func TestMinterValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestNextInflationRate(t *testing.T) {
+	lastUpdate := time.Now()
+	minter := NewMinter(lastUpdate, sdk.NewIntWithDecimal(100, 18))
+	params := DefaultParams()
+	year := secondsPerYear * time.Second
+
+	tests := []struct {
+		inflation, bondedRatio sdk.Dec
+		elapsed                time.Duration
+		expected               sdk.Dec
+	}{
+		// bonded at the goal, inflation does not change
+		{sdk.NewDecWithPrec(10, 2), params.GoalBonded, 5 * time.Second, sdk.NewDecWithPrec(10, 2)},
+		// nothing bonded, inflation grows by InflationRateChange per year
+		{sdk.NewDecWithPrec(10, 2), sdk.ZeroDec(), 30 * time.Second, sdk.NewDecWithPrec(10, 2).Add(params.InflationRateChange.MulInt64(int64(30 * time.Second)).QuoInt64(int64(year)))},
+		// fully bonded, inflation drops but not below the min
+		{sdk.NewDecWithPrec(2, 2), sdk.OneDec(), 5 * time.Second, params.InflationMin},
+		// nothing bonded, inflation grows but not above the max
+		{sdk.NewDecWithPrec(2, 1), sdk.ZeroDec(), 5 * time.Second, params.InflationMax},
+		// inflation below the min is raised to the min
+		{sdk.ZeroDec(), params.GoalBonded, 5 * time.Second, params.InflationMin},
+	}
+	for i, tc := range tests {
+		minter.Inflation = tc.inflation
+		inflation := minter.NextInflationRate(params, tc.bondedRatio, lastUpdate.Add(tc.elapsed))
+		require.True(t, tc.expected.Equal(inflation), "%d: expected %s, got %s", i, tc.expected, inflation)
+	}
+}
+
+func TestValidateMaxProvisionDuration(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.MaxProvisionDuration = 0
+	require.Error(t, params.Validate())
+
+	params.MaxProvisionDuration = -time.Second
+	require.Error(t, params.Validate())
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -23,6 +24,24 @@ var (
 	// params store for inflation params
 	KeyInflation = []byte("Inflation")
 	KeyMintDenom = []byte("MintDenom")
+	KeySchedule  = []byte("Schedule")
+
+	// params store for bonded ratio inflation params
+	KeyMode                = []byte("Mode")
+	KeyInflationMin        = []byte("InflationMin")
+	KeyInflationMax        = []byte("InflationMax")
+	KeyGoalBonded          = []byte("GoalBonded")
+	KeyInflationRateChange = []byte("InflationRateChange")
+
+	// params store for the distribution of minted coins
+	KeyDistribution = []byte("Distribution")
+
+	// params store for the issuance ledger
+	KeyIssuanceEpoch     = []byte("IssuanceEpoch")
+	KeyIssuanceRetention = []byte("IssuanceRetention")
+
+	// params store for the cap on the elapsed time of a block
+	KeyMaxProvisionDuration = []byte("MaxProvisionDuration")
 )
 
 // ParamTable for mint module
@@ -30,18 +49,30 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(mintDenom string, inflation sdk.Dec) Params {
-	return Params{
-		MintDenom: mintDenom,
-		Inflation: inflation,
-	}
+// NewParams creates flat mode params, with the default bonded ratio inflation params
+func NewParams(mintDenom string, inflation sdk.Dec, schedule []InflationStep) Params {
+	params := DefaultParams()
+	params.MintDenom = mintDenom
+	params.Inflation = inflation
+	params.Schedule = schedule
+	return params
 }
 
 // DefaultParams returns default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		Inflation: sdk.NewDecWithPrec(4, 2),
-		MintDenom: MintDenom,
+		Inflation:           sdk.NewDecWithPrec(4, 2),
+		MintDenom:           MintDenom,
+		Mode:                Flat,
+		InflationMin:        sdk.NewDecWithPrec(2, 2),
+		InflationMax:        sdk.NewDecWithPrec(2, 1),
+		GoalBonded:          sdk.NewDecWithPrec(67, 2),
+		InflationRateChange: sdk.NewDecWithPrec(13, 2),
+		Distribution:        DefaultDistribution(),
+		IssuanceEpoch:       17280, // about one day of 5 second blocks
+		IssuanceRetention:   0,
+		// a chain halt doesn't mint a huge lump in the first block after recovery
+		MaxProvisionDuration: 60 * time.Second,
 	}
 }
 
@@ -56,6 +87,16 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyInflation, &p.Inflation, validateInflation),
 		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
+		paramtypes.NewParamSetPair(KeySchedule, &p.Schedule, validateSchedule),
+		paramtypes.NewParamSetPair(KeyMode, &p.Mode, validateMode),
+		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflation),
+		paramtypes.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateInflation),
+		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
+		paramtypes.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateInflationRateChange),
+		paramtypes.NewParamSetPair(KeyDistribution, &p.Distribution, validateDistribution),
+		paramtypes.NewParamSetPair(KeyIssuanceEpoch, &p.IssuanceEpoch, validateIssuanceEpoch),
+		paramtypes.NewParamSetPair(KeyIssuanceRetention, &p.IssuanceRetention, validateIssuanceRetention),
+		paramtypes.NewParamSetPair(KeyMaxProvisionDuration, &p.MaxProvisionDuration, validateMaxProvisionDuration),
 	}
 }
 
@@ -72,7 +113,48 @@ func (p Params) Validate() error {
 	if len(p.MintDenom) == 0 {
 		return sdkerrors.Wrapf(ErrInvalidMintDenom, "Mint denom [%s] should not be empty", p.MintDenom)
 	}
-	return nil
+	if err := validateMode(p.Mode); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMintInflation, err.Error())
+	}
+	if err := validateInflation(p.InflationMin); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMintInflation, err.Error())
+	}
+	if err := validateInflation(p.InflationMax); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMintInflation, err.Error())
+	}
+	if p.InflationMin.GT(p.InflationMax) {
+		return sdkerrors.Wrapf(ErrInvalidMintInflation, "Mint inflation min [%s] should not be greater than inflation max [%s]", p.InflationMin, p.InflationMax)
+	}
+	if err := validateGoalBonded(p.GoalBonded); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMintInflation, err.Error())
+	}
+	if err := validateInflationRateChange(p.InflationRateChange); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMintInflation, err.Error())
+	}
+	if err := ValidateDistribution(p.Distribution); err != nil {
+		return err
+	}
+	if err := validateIssuanceEpoch(p.IssuanceEpoch); err != nil {
+		return sdkerrors.Wrap(ErrInvalidIssuance, err.Error())
+	}
+	if err := validateMaxProvisionDuration(p.MaxProvisionDuration); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMintInflation, err.Error())
+	}
+	return ValidateSchedule(p.Schedule)
+}
+
+// InflationAt returns the inflation rate in effect at the given height and time, that is
+// the rate of the last schedule step reached, or the flat inflation if no step is reached.
+// The steps of a valid schedule are of a single kind in increasing order, so the last step
+// reached is the one which took effect most recently
+func (p Params) InflationAt(height int64, blockTime time.Time) sdk.Dec {
+	inflation := p.Inflation
+	for _, step := range p.Schedule {
+		if step.Reached(height, blockTime) {
+			inflation = step.RateAt(blockTime)
+		}
+	}
+	return inflation
 }
 
 func validateInflation(i interface{}) error {
@@ -81,13 +163,70 @@ func validateInflation(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v.GT(sdk.NewDecWithPrec(2, 1)) || v.LT(sdk.ZeroDec()) {
+	if v.IsNil() || v.GT(sdk.NewDecWithPrec(2, 1)) || v.LT(sdk.ZeroDec()) {
 		return fmt.Errorf("Mint inflation [%s] should be between [0, 0.2] ", v.String())
 	}
 
 	return nil
 }
 
+func validateMode(i interface{}) error {
+	v, ok := i.(InflationMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid inflation mode [%d]", v)
+	}
+
+	return nil
+}
+
+func validateGoalBonded(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("goal bonded [%s] should be between (0, 1]", v.String())
+	}
+
+	return nil
+}
+
+func validateInflationRateChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("inflation rate change [%s] should be between [0, 1]", v.String())
+	}
+
+	return nil
+}
+
+func validateSchedule(i interface{}) error {
+	v, ok := i.([]InflationStep)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateSchedule(v)
+}
+
+func validateDistribution(i interface{}) error {
+	v, ok := i.([]DistributionTarget)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateDistribution(v)
+}
+
 func validateMintDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -103,3 +242,37 @@ func validateMintDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateIssuanceEpoch(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("issuance epoch must be positive")
+	}
+
+	return nil
+}
+
+func validateIssuanceRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxProvisionDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max provision duration [%s] must be positive", v)
+	}
+
+	return nil
+}
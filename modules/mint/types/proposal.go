@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeMintBaseUpdate defines the type for a MintBaseUpdateProposal
+	ProposalTypeMintBaseUpdate = "MintBaseUpdate"
+)
+
+// Assert MintBaseUpdateProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &MintBaseUpdateProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeMintBaseUpdate)
+	govtypes.RegisterProposalTypeCodec(&MintBaseUpdateProposal{}, "irishub/mint/MintBaseUpdateProposal")
+}
+
+// NewMintBaseUpdateProposal creates a new proposal to update the inflation base to the given base
+func NewMintBaseUpdateProposal(title, description string, inflationBase sdk.Int) *MintBaseUpdateProposal {
+	return &MintBaseUpdateProposal{
+		Title:         title,
+		Description:   description,
+		InflationBase: inflationBase,
+	}
+}
+
+// NewMintBaseUpdateFromSupplyProposal creates a new proposal to update the inflation base
+// to the supply of the mint denom when the proposal passes
+func NewMintBaseUpdateFromSupplyProposal(title, description string) *MintBaseUpdateProposal {
+	return &MintBaseUpdateProposal{
+		Title:         title,
+		Description:   description,
+		InflationBase: sdk.ZeroInt(),
+		FromSupply:    true,
+	}
+}
+
+// GetTitle returns the title of a mint base update proposal.
+func (p *MintBaseUpdateProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a mint base update proposal.
+func (p *MintBaseUpdateProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a mint base update proposal.
+func (p *MintBaseUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a mint base update proposal.
+func (p *MintBaseUpdateProposal) ProposalType() string { return ProposalTypeMintBaseUpdate }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *MintBaseUpdateProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.FromSupply {
+		if !p.InflationBase.IsNil() && !p.InflationBase.IsZero() {
+			return sdkerrors.Wrap(ErrInvalidInflationBase, "inflation base must be empty when derived from the supply")
+		}
+		return nil
+	}
+	if p.InflationBase.IsNil() || !p.InflationBase.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInflationBase, "inflation base (%s) must be positive", p.InflationBase)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p MintBaseUpdateProposal) String() string {
+	inflationBase := "current supply"
+	if !p.FromSupply {
+		inflationBase = p.InflationBase.String()
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Mint Base Update Proposal:
+  Title:          %s
+  Description:    %s
+  Inflation Base: %s
+`, p.Title, p.Description, inflationBase))
+	return b.String()
+}
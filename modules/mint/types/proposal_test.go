@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMintBaseUpdateProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		proposal *MintBaseUpdateProposal
+		expPass  bool
+	}{
+		{"explicit base", NewMintBaseUpdateProposal("title", "description", sdk.NewInt(1000)), true},
+		{"from supply", NewMintBaseUpdateFromSupplyProposal("title", "description"), true},
+		{"zero base", NewMintBaseUpdateProposal("title", "description", sdk.ZeroInt()), false},
+		{"negative base", NewMintBaseUpdateProposal("title", "description", sdk.NewInt(-1)), false},
+		{"base with from supply", &MintBaseUpdateProposal{Title: "title", Description: "description", InflationBase: sdk.NewInt(1000), FromSupply: true}, false},
+		{"empty title", NewMintBaseUpdateProposal("", "description", sdk.NewInt(1000)), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -6,10 +6,14 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -17,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -120,35 +126,525 @@ func (m *QueryParamsResponse) GetRes() *query.PageResponse {
 	return nil
 }
 
+// QueryMinterRequest is request type for the Query/Minter RPC method
+type QueryMinterRequest struct {
+}
+
+func (m *QueryMinterRequest) Reset()         { *m = QueryMinterRequest{} }
+func (m *QueryMinterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterRequest) ProtoMessage()    {}
+func (*QueryMinterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{2}
+}
+func (m *QueryMinterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterRequest.Merge(m, src)
+}
+func (m *QueryMinterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinterRequest proto.InternalMessageInfo
+
+// QueryMinterResponse is response type for the Query/Minter RPC method
+type QueryMinterResponse struct {
+	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
+}
+
+func (m *QueryMinterResponse) Reset()         { *m = QueryMinterResponse{} }
+func (m *QueryMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterResponse) ProtoMessage()    {}
+func (*QueryMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{3}
+}
+func (m *QueryMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterResponse.Merge(m, src)
+}
+func (m *QueryMinterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinterResponse proto.InternalMessageInfo
+
+func (m *QueryMinterResponse) GetMinter() Minter {
+	if m != nil {
+		return m.Minter
+	}
+	return Minter{}
+}
+
+// QueryInflationRequest is request type for the Query/Inflation RPC method
+type QueryInflationRequest struct {
+}
+
+func (m *QueryInflationRequest) Reset()         { *m = QueryInflationRequest{} }
+func (m *QueryInflationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationRequest) ProtoMessage()    {}
+func (*QueryInflationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{4}
+}
+func (m *QueryInflationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationRequest.Merge(m, src)
+}
+func (m *QueryInflationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationRequest proto.InternalMessageInfo
+
+// QueryInflationResponse is response type for the Query/Inflation RPC method
+type QueryInflationResponse struct {
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+}
+
+func (m *QueryInflationResponse) Reset()         { *m = QueryInflationResponse{} }
+func (m *QueryInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationResponse) ProtoMessage()    {}
+func (*QueryInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{5}
+}
+func (m *QueryInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationResponse.Merge(m, src)
+}
+func (m *QueryInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationResponse proto.InternalMessageInfo
+
+// QueryAnnualProvisionsRequest is request type for the Query/AnnualProvisions RPC method
+type QueryAnnualProvisionsRequest struct {
+}
+
+func (m *QueryAnnualProvisionsRequest) Reset()         { *m = QueryAnnualProvisionsRequest{} }
+func (m *QueryAnnualProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsRequest) ProtoMessage()    {}
+func (*QueryAnnualProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{6}
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnnualProvisionsRequest proto.InternalMessageInfo
+
+// QueryAnnualProvisionsResponse is response type for the Query/AnnualProvisions RPC method
+type QueryAnnualProvisionsResponse struct {
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions" yaml:"annual_provisions"`
+}
+
+func (m *QueryAnnualProvisionsResponse) Reset()         { *m = QueryAnnualProvisionsResponse{} }
+func (m *QueryAnnualProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsResponse) ProtoMessage()    {}
+func (*QueryAnnualProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{7}
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
+
+// QueryBlockProvisionRequest is request type for the Query/BlockProvision RPC method
+type QueryBlockProvisionRequest struct {
+}
+
+func (m *QueryBlockProvisionRequest) Reset()         { *m = QueryBlockProvisionRequest{} }
+func (m *QueryBlockProvisionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProvisionRequest) ProtoMessage()    {}
+func (*QueryBlockProvisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{8}
+}
+func (m *QueryBlockProvisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProvisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProvisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockProvisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProvisionRequest.Merge(m, src)
+}
+func (m *QueryBlockProvisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProvisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProvisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockProvisionRequest proto.InternalMessageInfo
+
+// QueryBlockProvisionResponse is response type for the Query/BlockProvision RPC method
+type QueryBlockProvisionResponse struct {
+	BlockProvision types.Coin `protobuf:"bytes,1,opt,name=block_provision,json=blockProvision,proto3" json:"block_provision" yaml:"block_provision"`
+}
+
+func (m *QueryBlockProvisionResponse) Reset()         { *m = QueryBlockProvisionResponse{} }
+func (m *QueryBlockProvisionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProvisionResponse) ProtoMessage()    {}
+func (*QueryBlockProvisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{9}
+}
+func (m *QueryBlockProvisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProvisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProvisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockProvisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProvisionResponse.Merge(m, src)
+}
+func (m *QueryBlockProvisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProvisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProvisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockProvisionResponse proto.InternalMessageInfo
+
+func (m *QueryBlockProvisionResponse) GetBlockProvision() types.Coin {
+	if m != nil {
+		return m.BlockProvision
+	}
+	return types.Coin{}
+}
+
+// QueryIssuancesRequest is request type for the Query/Issuances RPC method
+type QueryIssuancesRequest struct {
+	// epochs ending before the start height are skipped, if not zero
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// epochs starting after the end height are skipped, if not zero
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// epochs ending before the start time are skipped, if set
+	StartTime *time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// epochs starting after the end time are skipped, if set
+	EndTime    *time.Time         `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIssuancesRequest) Reset()         { *m = QueryIssuancesRequest{} }
+func (m *QueryIssuancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuancesRequest) ProtoMessage()    {}
+func (*QueryIssuancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{10}
+}
+func (m *QueryIssuancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIssuancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIssuancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuancesRequest.Merge(m, src)
+}
+func (m *QueryIssuancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIssuancesRequest proto.InternalMessageInfo
+
+func (m *QueryIssuancesRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryIssuancesRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryIssuancesRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *QueryIssuancesRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *QueryIssuancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIssuancesResponse is response type for the Query/Issuances RPC method
+type QueryIssuancesResponse struct {
+	Issuances  []Issuance          `protobuf:"bytes,1,rep,name=issuances,proto3" json:"issuances"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIssuancesResponse) Reset()         { *m = QueryIssuancesResponse{} }
+func (m *QueryIssuancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuancesResponse) ProtoMessage()    {}
+func (*QueryIssuancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{11}
+}
+func (m *QueryIssuancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIssuancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIssuancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuancesResponse.Merge(m, src)
+}
+func (m *QueryIssuancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIssuancesResponse proto.InternalMessageInfo
+
+func (m *QueryIssuancesResponse) GetIssuances() []Issuance {
+	if m != nil {
+		return m.Issuances
+	}
+	return nil
+}
+
+func (m *QueryIssuancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "irishub.mint.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "irishub.mint.QueryParamsResponse")
+	proto.RegisterType((*QueryMinterRequest)(nil), "irishub.mint.QueryMinterRequest")
+	proto.RegisterType((*QueryMinterResponse)(nil), "irishub.mint.QueryMinterResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "irishub.mint.QueryInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "irishub.mint.QueryInflationResponse")
+	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "irishub.mint.QueryAnnualProvisionsRequest")
+	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "irishub.mint.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryBlockProvisionRequest)(nil), "irishub.mint.QueryBlockProvisionRequest")
+	proto.RegisterType((*QueryBlockProvisionResponse)(nil), "irishub.mint.QueryBlockProvisionResponse")
+	proto.RegisterType((*QueryIssuancesRequest)(nil), "irishub.mint.QueryIssuancesRequest")
+	proto.RegisterType((*QueryIssuancesResponse)(nil), "irishub.mint.QueryIssuancesResponse")
 }
 
 func init() { proto.RegisterFile("mint/query.proto", fileDescriptor_3082aecef156f565) }
 
 var fileDescriptor_3082aecef156f565 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4a, 0x33, 0x31,
-	0x10, 0xc7, 0x77, 0xfb, 0x7d, 0xf6, 0x10, 0x05, 0x25, 0x2e, 0x52, 0x96, 0x1a, 0x6b, 0x4f, 0x1e,
-	0x34, 0x81, 0xfa, 0x06, 0xbd, 0x78, 0xad, 0x3d, 0x7a, 0x4b, 0x6b, 0x88, 0xc1, 0x6e, 0x26, 0xdd,
-	0x64, 0x91, 0x5e, 0x7d, 0x02, 0xc1, 0x97, 0xea, 0xb1, 0xe0, 0xc5, 0x93, 0xc8, 0xae, 0x0f, 0x22,
-	0x49, 0x56, 0xb4, 0x08, 0x5e, 0x42, 0xf8, 0xe7, 0x37, 0xbf, 0xcc, 0x0c, 0x3a, 0x28, 0x94, 0x76,
-	0x6c, 0x59, 0x89, 0x72, 0x45, 0x4d, 0x09, 0x0e, 0xf0, 0x9e, 0x2a, 0x95, 0xbd, 0xab, 0x66, 0xd4,
-	0xbf, 0xe4, 0xc7, 0x73, 0xb0, 0x05, 0xd8, 0x48, 0x30, 0xc3, 0xa5, 0xd2, 0xdc, 0x29, 0xd0, 0x11,
-	0xce, 0xf7, 0x43, 0xb9, 0x3f, 0xda, 0x20, 0x93, 0x20, 0x21, 0x5c, 0x99, 0xbf, 0xb5, 0x69, 0x5f,
-	0x02, 0xc8, 0x85, 0x60, 0xdc, 0x28, 0xc6, 0xb5, 0x06, 0x17, 0x1c, 0x36, 0xbe, 0x0e, 0x33, 0x84,
-	0xaf, 0xbd, 0x7e, 0xc2, 0x4b, 0x5e, 0xd8, 0xa9, 0x58, 0x56, 0xc2, 0xba, 0xe1, 0x03, 0x3a, 0xdc,
-	0x4a, 0xad, 0x01, 0x6d, 0x05, 0x1e, 0xa1, 0xae, 0x09, 0x49, 0x2f, 0x1d, 0xa4, 0x67, 0xbb, 0xa3,
-	0x8c, 0xfe, 0xec, 0x97, 0x46, 0x7a, 0xfc, 0x7f, 0xfd, 0x76, 0x92, 0x4c, 0x5b, 0x12, 0x9f, 0xa3,
-	0x7f, 0xa5, 0xb0, 0xbd, 0x4e, 0x28, 0xc8, 0x69, 0x1c, 0x89, 0xc6, 0xa1, 0x27, 0x5c, 0x8a, 0x2f,
-	0xf9, 0xd4, 0x63, 0x23, 0x87, 0x76, 0xc2, 0xc7, 0xf8, 0x1e, 0x75, 0xa3, 0x0e, 0x0f, 0xb6, 0x3f,
-	0xf9, 0xdd, 0x6d, 0x7e, 0xfa, 0x07, 0x11, 0xe5, 0xc3, 0xfe, 0xe3, 0xcb, 0xc7, 0x73, 0xe7, 0x08,
-	0x67, 0xac, 0x45, 0xc3, 0xde, 0x58, 0xec, 0x71, 0x7c, 0xb5, 0xae, 0x49, 0xba, 0xa9, 0x49, 0xfa,
-	0x5e, 0x93, 0xf4, 0xa9, 0x21, 0xc9, 0xa6, 0x21, 0xc9, 0x6b, 0x43, 0x92, 0x9b, 0x0b, 0xa9, 0x9c,
-	0x17, 0xcf, 0xa1, 0x08, 0x95, 0x5a, 0xb8, 0x6f, 0x03, 0xdc, 0x56, 0x0b, 0x61, 0xa3, 0xc9, 0xad,
-	0x8c, 0xb0, 0xb3, 0x6e, 0x58, 0xea, 0xe5, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39, 0xa6, 0xdd,
-	0x29, 0xda, 0x01, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x53, 0xc3, 0x44,
+	0x14, 0x6e, 0x5a, 0xa8, 0x76, 0x61, 0x00, 0x97, 0x5a, 0x4a, 0x6c, 0x13, 0x1a, 0x51, 0xf1, 0x07,
+	0xc9, 0x50, 0x4f, 0xe2, 0xc1, 0xb1, 0x3a, 0xa3, 0x38, 0x3a, 0x83, 0x19, 0x4f, 0x5e, 0x98, 0x4d,
+	0xbb, 0x84, 0x4c, 0x9b, 0xdd, 0x90, 0x4d, 0x61, 0x38, 0xea, 0xd9, 0x03, 0xa3, 0x1e, 0xfc, 0x93,
+	0x38, 0x32, 0xe3, 0xc5, 0xf1, 0x50, 0x1d, 0xf0, 0x2f, 0xe0, 0xe6, 0xcd, 0xc9, 0xfe, 0x68, 0x9b,
+	0x10, 0x0a, 0x5e, 0xa0, 0x79, 0xef, 0x7b, 0xef, 0xfb, 0x76, 0xdf, 0xbe, 0x0f, 0x6c, 0x84, 0x01,
+	0x49, 0x9c, 0xf3, 0x31, 0x8e, 0xaf, 0xec, 0x28, 0xa6, 0x09, 0x85, 0xab, 0x41, 0x1c, 0xb0, 0xb3,
+	0xb1, 0x67, 0xa7, 0x19, 0xbd, 0xdd, 0xa7, 0x2c, 0xa4, 0x4c, 0x20, 0x9c, 0x08, 0xf9, 0x01, 0x41,
+	0x49, 0x40, 0x89, 0x00, 0xeb, 0x5b, 0x22, 0x7d, 0xc2, 0xbf, 0x9c, 0x3e, 0x0d, 0x54, 0x62, 0x9d,
+	0xf7, 0x4d, 0xff, 0xc8, 0x40, 0xdd, 0xa7, 0x3e, 0x15, 0xb0, 0xf4, 0x97, 0x8c, 0xb6, 0x7c, 0x4a,
+	0xfd, 0x11, 0x76, 0x50, 0x14, 0x38, 0x88, 0x10, 0x9a, 0xf0, 0xe6, 0x4c, 0x66, 0x4d, 0x99, 0xe5,
+	0x5f, 0xde, 0xf8, 0xd4, 0x49, 0x82, 0x10, 0xb3, 0x04, 0x85, 0x91, 0x00, 0x58, 0x75, 0x00, 0xbf,
+	0x4d, 0x85, 0x1d, 0xa3, 0x18, 0x85, 0xcc, 0xc5, 0xe7, 0x63, 0xcc, 0x12, 0xeb, 0x12, 0x6c, 0x66,
+	0xa2, 0x2c, 0xa2, 0x84, 0x61, 0xd8, 0x05, 0xd5, 0x88, 0x47, 0x9a, 0xda, 0x8e, 0xb6, 0xb7, 0xd2,
+	0xad, 0xdb, 0xf3, 0x27, 0xb5, 0x05, 0xba, 0xb7, 0x74, 0x33, 0x31, 0x4b, 0xae, 0x44, 0xc2, 0x0f,
+	0x40, 0x25, 0xc6, 0xac, 0x59, 0xe6, 0x05, 0xba, 0x2d, 0x4e, 0x6b, 0x8b, 0xeb, 0x3a, 0x46, 0x3e,
+	0x56, 0xcd, 0xdd, 0x14, 0x36, 0x95, 0xf3, 0x4d, 0x40, 0x12, 0x1c, 0x2b, 0x39, 0x47, 0x60, 0x33,
+	0x13, 0x9d, 0xc9, 0x09, 0x79, 0xa4, 0x58, 0x8e, 0x40, 0x2b, 0x39, 0x02, 0x69, 0x6d, 0x81, 0xd7,
+	0x79, 0xab, 0x23, 0x72, 0x3a, 0xe2, 0x37, 0xa5, 0x38, 0x4e, 0x41, 0x23, 0x9f, 0x90, 0x34, 0x5f,
+	0x83, 0x5a, 0xa0, 0x82, 0x9c, 0xa9, 0xd6, 0xb3, 0xd3, 0x9e, 0x7f, 0x4e, 0xcc, 0xb7, 0xfd, 0x20,
+	0x49, 0xf9, 0xfa, 0x34, 0x74, 0xe4, 0x98, 0xc5, 0xbf, 0x7d, 0x36, 0x18, 0x3a, 0xc9, 0x55, 0x84,
+	0x99, 0xfd, 0x39, 0xee, 0xbb, 0xb3, 0x06, 0x96, 0x01, 0x5a, 0x9c, 0xe7, 0x53, 0x42, 0xc6, 0x68,
+	0x74, 0x1c, 0xd3, 0x8b, 0x80, 0xa5, 0x03, 0x53, 0x3a, 0x7e, 0xd3, 0x40, 0xfb, 0x09, 0x80, 0xd4,
+	0x73, 0x09, 0x5e, 0x43, 0x3c, 0x77, 0x12, 0x4d, 0x93, 0x52, 0xd7, 0x57, 0xff, 0x4f, 0xd7, 0xc3,
+	0xc4, 0x6c, 0x5e, 0xa1, 0x70, 0x74, 0x68, 0x3d, 0x6a, 0x68, 0xb9, 0x1b, 0x28, 0x27, 0xc0, 0x6a,
+	0x01, 0x9d, 0x2b, 0xeb, 0x8d, 0x68, 0x7f, 0x38, 0x8d, 0x2b, 0xe1, 0x3f, 0x68, 0xe0, 0x8d, 0xc2,
+	0xb4, 0x94, 0xed, 0x81, 0x75, 0x2f, 0xcd, 0xcc, 0x48, 0xe4, 0xd8, 0xb6, 0xd5, 0xa3, 0xf0, 0x10,
+	0xc3, 0xf6, 0xc5, 0x81, 0x87, 0x13, 0x74, 0x60, 0x7f, 0x46, 0x03, 0xd2, 0x33, 0xd2, 0xf3, 0x3c,
+	0x4c, 0xcc, 0x86, 0x50, 0x99, 0xab, 0xb7, 0xdc, 0x35, 0x2f, 0xc3, 0x65, 0xfd, 0x5c, 0x56, 0xe3,
+	0x65, 0x6c, 0x8c, 0x48, 0x1f, 0xab, 0x6b, 0x85, 0x1d, 0xb0, 0xca, 0x12, 0x14, 0x27, 0x27, 0x67,
+	0x38, 0xf0, 0xcf, 0x12, 0x4e, 0x5d, 0x71, 0x57, 0x78, 0xec, 0x4b, 0x1e, 0x82, 0x6d, 0x00, 0x30,
+	0x19, 0x28, 0x40, 0x99, 0x03, 0x6a, 0x98, 0x0c, 0x64, 0xfa, 0x13, 0x00, 0x44, 0x87, 0x74, 0x85,
+	0x9a, 0x15, 0xf9, 0x9e, 0xc5, 0x7e, 0xd9, 0x6a, 0xbf, 0xec, 0xef, 0xd4, 0x7e, 0xf5, 0x96, 0xae,
+	0xff, 0x32, 0x35, 0xb7, 0xc6, 0x6b, 0xd2, 0x28, 0xfc, 0x18, 0xbc, 0x9a, 0xf6, 0xe7, 0xe5, 0x4b,
+	0x2f, 0x2c, 0x7f, 0x05, 0x93, 0x01, 0x2f, 0xfe, 0x08, 0x80, 0x99, 0x75, 0x34, 0x97, 0xb3, 0x17,
+	0x37, 0xbf, 0x4d, 0xfc, 0xb8, 0xee, 0x1c, 0xd8, 0xba, 0xd6, 0x40, 0x23, 0x7f, 0x29, 0x72, 0x26,
+	0x87, 0xa0, 0x16, 0xa8, 0x60, 0x53, 0xdb, 0xa9, 0xec, 0xad, 0x74, 0x1b, 0xd9, 0x25, 0x52, 0x35,
+	0x72, 0x8d, 0x66, 0x70, 0x78, 0x98, 0x51, 0xf4, 0xfc, 0x7e, 0xcf, 0xa1, 0xbb, 0xff, 0x2e, 0x83,
+	0x65, 0x2e, 0x09, 0x0e, 0x41, 0x55, 0xd8, 0x06, 0xdc, 0xc9, 0x12, 0x3f, 0x76, 0x25, 0xbd, 0xb3,
+	0x00, 0x21, 0x48, 0xac, 0xd6, 0x8f, 0xbf, 0xff, 0xf3, 0x4b, 0xb9, 0x01, 0xeb, 0x8e, 0x84, 0x72,
+	0x03, 0x75, 0xa4, 0x17, 0x0d, 0x41, 0x55, 0x98, 0x42, 0x21, 0x59, 0xc6, 0x73, 0xf4, 0xce, 0x02,
+	0xc4, 0x62, 0xb2, 0x50, 0x50, 0x5c, 0x82, 0xda, 0xd4, 0x4b, 0xe0, 0x9b, 0x05, 0xdd, 0xf2, 0x16,
+	0xa4, 0xef, 0x2e, 0x06, 0x49, 0x56, 0x93, 0xb3, 0x6e, 0xc3, 0xad, 0x2c, 0xeb, 0xd4, 0x61, 0xe0,
+	0xaf, 0x1a, 0xd8, 0xc8, 0x9b, 0x07, 0x7c, 0xaf, 0xa0, 0xf7, 0x13, 0x16, 0xa4, 0xbf, 0xff, 0x22,
+	0xac, 0x94, 0xf3, 0x0e, 0x97, 0xd3, 0x81, 0x66, 0x56, 0xce, 0x23, 0x43, 0x81, 0x3f, 0x69, 0x60,
+	0x2d, 0x6b, 0x0d, 0x70, 0xaf, 0x80, 0xa8, 0xd0, 0x5c, 0xf4, 0x77, 0x5f, 0x80, 0x94, 0x82, 0xde,
+	0xe2, 0x82, 0x4c, 0xd8, 0xce, 0x0a, 0xca, 0x79, 0x07, 0x1f, 0xcf, 0xf4, 0x2d, 0x17, 0x8e, 0x27,
+	0x67, 0x21, 0xfa, 0xee, 0x62, 0xd0, 0x33, 0xe3, 0x51, 0xc0, 0xde, 0x17, 0x37, 0x77, 0x86, 0x76,
+	0x7b, 0x67, 0x68, 0x7f, 0xdf, 0x19, 0xda, 0xf5, 0xbd, 0x51, 0xba, 0xbd, 0x37, 0x4a, 0x7f, 0xdc,
+	0x1b, 0xa5, 0xef, 0xf7, 0xe7, 0x5c, 0x3b, 0x2d, 0x26, 0x38, 0x99, 0x35, 0xa1, 0x83, 0xf1, 0x08,
+	0x33, 0xd1, 0x8c, 0x1b, 0xb8, 0x57, 0xe5, 0xae, 0xf1, 0xe1, 0x7f, 0x03, 0x00, 0xf2, 0x92, 0xcb,
+	0x3e, 0x81, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -165,6 +661,16 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the mint parameters
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Minter queries the current minter state
+	Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error)
+	// Inflation queries the current inflation rate
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// AnnualProvisions queries the current annual provisions
+	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// BlockProvision queries the provision minted in the current block
+	BlockProvision(ctx context.Context, in *QueryBlockProvisionRequest, opts ...grpc.CallOption) (*QueryBlockProvisionResponse, error)
+	// Issuances queries the issuance ledger within a height and time range
+	Issuances(ctx context.Context, in *QueryIssuancesRequest, opts ...grpc.CallOption) (*QueryIssuancesResponse, error)
 }
 
 type queryClient struct {
@@ -184,11 +690,66 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the mint parameters
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-}
+func (c *queryClient) Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error) {
+	out := new(QueryMinterResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/Minter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/Inflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	out := new(QueryAnnualProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/AnnualProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockProvision(ctx context.Context, in *QueryBlockProvisionRequest, opts ...grpc.CallOption) (*QueryBlockProvisionResponse, error) {
+	out := new(QueryBlockProvisionResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/BlockProvision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Issuances(ctx context.Context, in *QueryIssuancesRequest, opts ...grpc.CallOption) (*QueryIssuancesResponse, error) {
+	out := new(QueryIssuancesResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/Issuances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the mint parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Minter queries the current minter state
+	Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error)
+	// Inflation queries the current inflation rate
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// AnnualProvisions queries the current annual provisions
+	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// BlockProvision queries the provision minted in the current block
+	BlockProvision(context.Context, *QueryBlockProvisionRequest) (*QueryBlockProvisionResponse, error)
+	// Issuances queries the issuance ledger within a height and time range
+	Issuances(context.Context, *QueryIssuancesRequest) (*QueryIssuancesResponse, error)
+}
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
@@ -197,6 +758,21 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Minter(ctx context.Context, req *QueryMinterRequest) (*QueryMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minter not implemented")
+}
+func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+}
+func (*UnimplementedQueryServer) BlockProvision(ctx context.Context, req *QueryBlockProvisionRequest) (*QueryBlockProvisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockProvision not implemented")
+}
+func (*UnimplementedQueryServer) Issuances(ctx context.Context, req *QueryIssuancesRequest) (*QueryIssuancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Issuances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -220,6 +796,96 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Minter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/Minter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minter(ctx, req.(*QueryMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/Inflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/AnnualProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockProvisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockProvision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/BlockProvision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockProvision(ctx, req.(*QueryBlockProvisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Issuances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Issuances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/Issuances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Issuances(ctx, req.(*QueryIssuancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "irishub.mint.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -228,6 +894,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Minter",
+			Handler:    _Query_Minter_Handler,
+		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "AnnualProvisions",
+			Handler:    _Query_AnnualProvisions_Handler,
+		},
+		{
+			MethodName: "BlockProvision",
+			Handler:    _Query_BlockProvision_Handler,
+		},
+		{
+			MethodName: "Issuances",
+			Handler:    _Query_Issuances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mint/query.proto",
@@ -301,48 +987,1245 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProvisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProvisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProvisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProvisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EndTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuances) > 0 {
+		for iNdEx := len(m.Issuances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Issuances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Res != nil {
+		l = m.Res.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockProvisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIssuancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issuances) > 0 {
+		for _, e := range m.Issuances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Res", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Res == nil {
+				m.Res = &query.PageResponse{}
+			}
+			if err := m.Res.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockProvisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProvisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlockProvisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProvisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Res != nil {
-		l = m.Res.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -365,12 +2248,158 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -395,7 +2424,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -418,15 +2447,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -453,13 +2482,14 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Issuances = append(m.Issuances, Issuance{})
+			if err := m.Issuances[len(m.Issuances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Res", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -486,10 +2516,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Res == nil {
-				m.Res = &query.PageResponse{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Res.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
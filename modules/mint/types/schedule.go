@@ -0,0 +1,93 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewInflationStep creates a new InflationStep instance; exactly one of
+// startTime and startHeight should be set
+func NewInflationStep(startTime *time.Time, startHeight int64, rate, decay sdk.Dec) InflationStep {
+	return InflationStep{
+		StartTime:   startTime,
+		StartHeight: startHeight,
+		Rate:        rate,
+		Decay:       decay,
+	}
+}
+
+// Reached returns true if the step has taken effect at the given height and time
+func (s InflationStep) Reached(height int64, blockTime time.Time) bool {
+	if s.StartTime != nil {
+		return !blockTime.Before(*s.StartTime)
+	}
+	return height >= s.StartHeight
+}
+
+// RateAt returns the rate of the step at the given time. The rate decays by Decay
+// each full year since the start time, and linearly within the current year
+func (s InflationStep) RateAt(blockTime time.Time) sdk.Dec {
+	if s.StartTime == nil || s.Decay.IsZero() || !blockTime.After(*s.StartTime) {
+		return s.Rate
+	}
+
+	year := int64(secondsPerYear * time.Second)
+	elapsed := int64(blockTime.Sub(*s.StartTime))
+	fraction := sdk.NewDec(elapsed % year).QuoInt64(year)
+
+	rate := s.Rate.Mul(sdk.OneDec().Sub(s.Decay).Power(uint64(elapsed / year)))
+	return rate.Mul(sdk.OneDec().Sub(s.Decay.Mul(fraction)))
+}
+
+// Validate returns err if the InflationStep is invalid
+func (s InflationStep) Validate() error {
+	if (s.StartTime == nil) == (s.StartHeight == 0) {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "exactly one of start time and start height should be set")
+	}
+	if s.StartHeight < 0 {
+		return sdkerrors.Wrapf(ErrInvalidSchedule, "start height [%d] should be positive", s.StartHeight)
+	}
+	if s.StartTime != nil && s.StartTime.Before(time.Unix(0, 0)) {
+		return sdkerrors.Wrapf(ErrInvalidSchedule, "start time [%s] should not be a time before January 1, 1970 UTC", s.StartTime.String())
+	}
+	if s.Rate.IsNil() || s.Rate.GT(sdk.NewDecWithPrec(2, 1)) || s.Rate.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidMintInflation, "schedule rate [%s] should be between [0, 0.2]", s.Rate)
+	}
+	if s.Decay.IsNil() || s.Decay.GTE(sdk.OneDec()) || s.Decay.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidSchedule, "decay [%s] should be between [0, 1)", s.Decay)
+	}
+	if s.StartTime == nil && !s.Decay.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "decay is only supported by steps with a start time")
+	}
+	return nil
+}
+
+// ValidateSchedule returns err if the schedule is invalid. The steps should either all start at
+// a time or all start at a height, in increasing order, since the time at which a height is reached
+// isn't known in advance and the two kinds of steps can't be ordered against each other
+func ValidateSchedule(schedule []InflationStep) error {
+	var lastTime *time.Time
+	var lastHeight int64
+	for i, step := range schedule {
+		if err := step.Validate(); err != nil {
+			return err
+		}
+		if i > 0 && (step.StartTime == nil) != (schedule[0].StartTime == nil) {
+			return sdkerrors.Wrap(ErrInvalidSchedule, "steps starting at a time and steps starting at a height cannot be mixed")
+		}
+		if step.StartTime != nil {
+			if lastTime != nil && !step.StartTime.After(*lastTime) {
+				return sdkerrors.Wrapf(ErrInvalidSchedule, "start time [%s] should be after [%s]", step.StartTime, lastTime)
+			}
+			lastTime = step.StartTime
+			continue
+		}
+		if step.StartHeight <= lastHeight {
+			return sdkerrors.Wrapf(ErrInvalidSchedule, "start height [%d] should be greater than [%d]", step.StartHeight, lastHeight)
+		}
+		lastHeight = step.StartHeight
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateSchedule(t *testing.T) {
+	t1 := time.Unix(1600000000, 0).UTC()
+	t2 := t1.Add(time.Hour)
+	before := time.Unix(-1, 0)
+
+	rate := sdk.NewDecWithPrec(5, 2)
+	decay := sdk.NewDecWithPrec(10, 2)
+
+	tests := []struct {
+		name       string
+		schedule   []InflationStep
+		expectPass bool
+	}{
+		{"empty schedule", nil, true},
+		{"height steps", []InflationStep{NewInflationStep(nil, 10, rate, sdk.ZeroDec()), NewInflationStep(nil, 20, rate, sdk.ZeroDec())}, true},
+		{"time steps", []InflationStep{NewInflationStep(&t1, 0, rate, decay), NewInflationStep(&t2, 0, rate, sdk.ZeroDec())}, true},
+		{"height step before a time step", []InflationStep{NewInflationStep(nil, 10, rate, sdk.ZeroDec()), NewInflationStep(&t1, 0, rate, decay)}, false},
+		{"time step before a height step", []InflationStep{NewInflationStep(&t1, 0, rate, decay), NewInflationStep(nil, 10, rate, sdk.ZeroDec())}, false},
+		{"no start", []InflationStep{NewInflationStep(nil, 0, rate, sdk.ZeroDec())}, false},
+		{"both starts", []InflationStep{NewInflationStep(&t1, 10, rate, sdk.ZeroDec())}, false},
+		{"negative height", []InflationStep{NewInflationStep(nil, -1, rate, sdk.ZeroDec())}, false},
+		{"time before 1970", []InflationStep{NewInflationStep(&before, 0, rate, sdk.ZeroDec())}, false},
+		{"rate too high", []InflationStep{NewInflationStep(nil, 10, sdk.NewDecWithPrec(21, 2), sdk.ZeroDec())}, false},
+		{"negative rate", []InflationStep{NewInflationStep(nil, 10, sdk.NewDecWithPrec(-1, 2), sdk.ZeroDec())}, false},
+		{"nil rate", []InflationStep{{StartHeight: 10, Decay: sdk.ZeroDec()}}, false},
+		{"decay of one", []InflationStep{NewInflationStep(&t1, 0, rate, sdk.OneDec())}, false},
+		{"decay on height step", []InflationStep{NewInflationStep(nil, 10, rate, decay)}, false},
+		{"heights not increasing", []InflationStep{NewInflationStep(nil, 20, rate, sdk.ZeroDec()), NewInflationStep(nil, 20, rate, sdk.ZeroDec())}, false},
+		{"times not increasing", []InflationStep{NewInflationStep(&t2, 0, rate, sdk.ZeroDec()), NewInflationStep(&t1, 0, rate, sdk.ZeroDec())}, false},
+	}
+	for _, tc := range tests {
+		err := ValidateSchedule(tc.schedule)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestInflationAt(t *testing.T) {
+	start := time.Unix(1600000000, 0).UTC()
+	year := secondsPerYear * time.Second
+
+	later := start.Add(year)
+
+	heightParams := DefaultParams()
+	heightParams.Schedule = []InflationStep{
+		NewInflationStep(nil, 100, sdk.NewDecWithPrec(10, 2), sdk.ZeroDec()),
+		NewInflationStep(nil, 200, sdk.NewDecWithPrec(6, 2), sdk.ZeroDec()),
+	}
+	require.NoError(t, heightParams.Validate())
+
+	timeParams := DefaultParams()
+	timeParams.Schedule = []InflationStep{
+		NewInflationStep(&start, 0, sdk.NewDecWithPrec(8, 2), sdk.NewDecWithPrec(50, 2)),
+		NewInflationStep(&later, 0, sdk.NewDecWithPrec(3, 2), sdk.ZeroDec()),
+	}
+	require.NoError(t, timeParams.Validate())
+
+	tests := []struct {
+		params    Params
+		height    int64
+		blockTime time.Time
+		expected  sdk.Dec
+	}{
+		{heightParams, 1, start, heightParams.Inflation},
+		{heightParams, 100, start, sdk.NewDecWithPrec(10, 2)},
+		{heightParams, 199, start.Add(year), sdk.NewDecWithPrec(10, 2)},
+		{heightParams, 200, start, sdk.NewDecWithPrec(6, 2)},
+		{timeParams, 1000, start.Add(-time.Hour), timeParams.Inflation},
+		{timeParams, 1, start, sdk.NewDecWithPrec(8, 2)},
+		{timeParams, 200, start.Add(year / 2), sdk.NewDecWithPrec(6, 2)},
+		{timeParams, 300, later, sdk.NewDecWithPrec(3, 2)},
+		{timeParams, 400, start.Add(2 * year), sdk.NewDecWithPrec(3, 2)},
+	}
+	for i, tc := range tests {
+		require.Equal(t, tc.expected, tc.params.InflationAt(tc.height, tc.blockTime), "%d", i)
+	}
+
+	// a height step can't follow a time step which may take effect after it
+	mixedParams := DefaultParams()
+	mixedParams.Schedule = []InflationStep{
+		NewInflationStep(&later, 0, sdk.NewDecWithPrec(8, 2), sdk.ZeroDec()),
+		NewInflationStep(nil, 100, sdk.NewDecWithPrec(10, 2), sdk.ZeroDec()),
+	}
+	require.Error(t, mixedParams.Validate())
+}
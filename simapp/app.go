@@ -110,10 +110,15 @@ import (
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
 	tokentypes "github.com/irisnet/irismod/modules/token/types"
 
+	"github.com/irisnet/irishub/modules/globalfee"
+	globalfeekeeper "github.com/irisnet/irishub/modules/globalfee/keeper"
+	globalfeetypes "github.com/irisnet/irishub/modules/globalfee/types"
 	"github.com/irisnet/irishub/modules/guardian"
+	guardianclient "github.com/irisnet/irishub/modules/guardian/client"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
 	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 	"github.com/irisnet/irishub/modules/mint"
+	mintclient "github.com/irisnet/irishub/modules/mint/client"
 	mintkeeper "github.com/irisnet/irishub/modules/mint/keeper"
 	minttypes "github.com/irisnet/irishub/modules/mint/types"
 )
@@ -137,6 +142,8 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			mintclient.ProposalHandler,
+			guardianclient.AddSuperProposalHandler, guardianclient.DeleteSuperProposalHandler, guardianclient.ChangeSuperTypeProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -147,6 +154,7 @@ var (
 		transfer.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		guardian.AppModuleBasic{},
+		globalfee.AppModuleBasic{},
 		token.AppModuleBasic{},
 		record.AppModuleBasic{},
 		nft.AppModuleBasic{},
@@ -218,15 +226,16 @@ type SimApp struct {
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
 	ScopedIBCMockKeeper  capabilitykeeper.ScopedKeeper
 
-	GuardianKeeper guardiankeeper.Keeper
-	TokenKeeper    tokenkeeper.Keeper
-	RecordKeeper   recordkeeper.Keeper
-	NFTKeeper      nftkeeper.Keeper
-	HTLCKeeper     htlckeeper.Keeper
-	CoinswapKeeper coinswapkeeper.Keeper
-	ServiceKeeper  servicekeeper.Keeper
-	OracleKeeper   oracleKeeper.Keeper
-	RandomKeeper   randomkeeper.Keeper
+	GuardianKeeper  guardiankeeper.Keeper
+	GlobalFeeKeeper globalfeekeeper.Keeper
+	TokenKeeper     tokenkeeper.Keeper
+	RecordKeeper    recordkeeper.Keeper
+	NFTKeeper       nftkeeper.Keeper
+	HTLCKeeper      htlckeeper.Keeper
+	CoinswapKeeper  coinswapkeeper.Keeper
+	ServiceKeeper   servicekeeper.Keeper
+	OracleKeeper    oracleKeeper.Keeper
+	RandomKeeper    randomkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -267,7 +276,7 @@ func NewSimApp(
 		guardiantypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
 		coinswaptypes.StoreKey, servicetypes.StoreKey, oracletypes.StoreKey, randomtypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, guardiantypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &SimApp{
@@ -304,10 +313,6 @@ func NewSimApp(
 	StakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
-	app.MintKeeper = mintkeeper.NewKeeper(
-		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
-	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&StakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
@@ -331,13 +336,36 @@ func NewSimApp(
 		appCodec, keys[ibchost.StoreKey], app.StakingKeeper, scopedIBCKeeper,
 	)
 
+	app.TokenKeeper = tokenkeeper.NewKeeper(
+		appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName),
+		app.BankKeeper, authtypes.FeeCollectorName,
+	)
+	app.MintKeeper = mintkeeper.NewKeeper(
+		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, &StakingKeeper, app.DistrKeeper, app.TokenKeeper, authtypes.FeeCollectorName,
+	)
+
+	app.GuardianKeeper = guardiankeeper.NewKeeper(
+		appCodec, keys[guardiantypes.StoreKey], tkeys[guardiantypes.TStoreKey], app.GetSubspace(guardiantypes.ModuleName),
+	)
+
+	app.GlobalFeeKeeper = globalfeekeeper.NewKeeper(app.GetSubspace(globalfeetypes.ModuleName))
+
+	// the param changes are checked against the constraints across the params and the module states
+	paramsHandler := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+	paramsHandler = globalfee.NewParamChangeProposalHandler(app.GlobalFeeKeeper, paramsHandler)
+	paramsHandler = guardian.NewParamChangeProposalHandler(app.GuardianKeeper, paramsHandler)
+	paramsHandler = mint.NewParamChangeProposalHandler(app.MintKeeper, paramsHandler)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, paramsHandler).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(minttypes.RouterKey, mint.NewMintBaseUpdateProposalHandler(app.MintKeeper)).
+		AddRoute(guardiantypes.RouterKey, guardian.NewProposalHandler(app.GuardianKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&StakingKeeper, govRouter,
@@ -368,11 +396,6 @@ func NewSimApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	app.GuardianKeeper = guardiankeeper.NewKeeper(appCodec, keys[guardiantypes.StoreKey])
-	app.TokenKeeper = tokenkeeper.NewKeeper(
-		appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName),
-		app.BankKeeper, authtypes.FeeCollectorName,
-	)
 	app.RecordKeeper = recordkeeper.NewKeeper(appCodec, keys[recordtypes.StoreKey])
 
 	app.NFTKeeper = nftkeeper.NewKeeper(appCodec, keys[nfttypes.StoreKey])
@@ -424,7 +447,8 @@ func NewSimApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
-		guardian.NewAppModule(appCodec, app.GuardianKeeper),
+		guardian.NewAppModule(appCodec, app.GuardianKeeper, app.AccountKeeper, app.BankKeeper),
+		globalfee.NewAppModule(appCodec, app.GlobalFeeKeeper),
 		token.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
 		record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper),
 		nft.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper),
@@ -446,7 +470,7 @@ func NewSimApp(
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
-		servicetypes.ModuleName,
+		servicetypes.ModuleName, guardiantypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -458,8 +482,8 @@ func NewSimApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
-		guardiantypes.ModuleName, tokentypes.ModuleName, nfttypes.ModuleName, htlctypes.ModuleName, recordtypes.ModuleName,
-		coinswaptypes.ModuleName, servicetypes.ModuleName, oracletypes.ModuleName, randomtypes.ModuleName,
+		guardiantypes.ModuleName, globalfeetypes.ModuleName, tokentypes.ModuleName, nfttypes.ModuleName, htlctypes.ModuleName,
+		recordtypes.ModuleName, coinswaptypes.ModuleName, servicetypes.ModuleName, oracletypes.ModuleName, randomtypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -486,7 +510,8 @@ func NewSimApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
-		guardian.NewAppModule(appCodec, app.GuardianKeeper),
+		guardian.NewAppModule(appCodec, app.GuardianKeeper, app.AccountKeeper, app.BankKeeper),
+		globalfee.NewAppModule(appCodec, app.GlobalFeeKeeper),
 		token.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
 		record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper),
 		nft.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper),
@@ -722,6 +747,8 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(htlctypes.ModuleName)
 	paramsKeeper.Subspace(coinswaptypes.ModuleName)
 	paramsKeeper.Subspace(servicetypes.ModuleName)
+	paramsKeeper.Subspace(guardiantypes.ModuleName)
+	paramsKeeper.Subspace(globalfeetypes.ModuleName)
 
 	return paramsKeeper
 }